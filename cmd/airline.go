@@ -0,0 +1,263 @@
+/*
+Copyright 2026 Joshua Chuah <jchuah07@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/joshuachuah/flightcli/internal/airlines"
+	"github.com/spf13/cobra"
+)
+
+var airlineCmd = &cobra.Command{
+	Use:   "airline [code]",
+	Short: "Look up an airline by ICAO or IATA code",
+	Long: `Show an airline's name, IATA and ICAO codes, radio callsign and country
+from the embedded airlines table. No API call is made.
+
+A 3-letter code is read as an ICAO designator (UAL) and a 2-character code as
+an IATA code (UA). IATA codes are often reused, so every airline in the table
+with that code is listed.
+
+Use 'airline search' to find an airline by name or callsign, and 'airline
+sources' to see which entries override files in ~/.flightcli/ changed.`,
+	Example: `  flightcli airline UAL
+  flightcli airline UA --json
+  flightcli airline search united`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		matches, err := lookupAirlines(args[0])
+		cobra.CheckErr(err)
+		if jsonOutput {
+			cobra.CheckErr(printJSONOutput(newAirlineInfos(matches)))
+			return
+		}
+		if len(matches) == 1 {
+			printAirlineInfo(newAirlineInfo(matches[0]))
+			return
+		}
+		fmt.Printf("%d airlines use IATA code %s:\n\n", len(matches), matches[0].IATA)
+		printAirlineTable(newAirlineInfos(matches))
+	},
+}
+
+var airlineSearchCmd = &cobra.Command{
+	Use:   "search [query]",
+	Short: "Find airlines by name or callsign",
+	Long: `Find airlines in the embedded airlines table whose name or radio callsign
+matches the query. Small typos are forgiven, so "lufthnasa" still finds
+Lufthansa. Exact codes and names are listed first.`,
+	Example: `  flightcli airline search united
+  flightcli airline search speedbird`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		query := strings.Join(args, " ")
+		matches := airlines.Search(query)
+		if len(matches) == 0 {
+			cobra.CheckErr(fmt.Errorf("no airlines match %q", query))
+		}
+		if jsonOutput {
+			cobra.CheckErr(printJSONOutput(newAirlineInfos(matches)))
+			return
+		}
+		printAirlineTable(newAirlineInfos(matches))
+	},
+}
+
+var airlineSourcesCmd = &cobra.Command{
+	Use:   "sources",
+	Short: "Show where airline data comes from",
+	Long: `Show the data behind the airlines table: the embedded OpenFlights data,
+the corrections built into flightcli, and any override files found in
+~/.flightcli/, with the entries each file changed and the ones it skipped.
+
+Override files are merged over the embedded table at startup, in this order:
+
+  airlines.dat   rows in the OpenFlights airlines.dat layout, no header
+  airlines.csv   a header row naming any of name, iata, icao, callsign,
+                 country and active, then one airline per row
+  airlines.json  an array of objects with the same keys
+
+Entries are matched by ICAO code, and fields left empty keep the embedded
+value. Set active to Y or N to choose between airlines sharing an IATA code.`,
+	Example: `  flightcli airline sources
+  flightcli airline sources --json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		report := newAirlineSourcesReport()
+		if jsonOutput {
+			cobra.CheckErr(printJSONOutput(report))
+			return
+		}
+		printAirlineSources(report)
+	},
+}
+
+// Results of loading override files, kept for 'airline sources'.
+var (
+	airlineOverrideDir string
+	airlineOverrideErr error
+)
+
+// loadAirlineOverrides merges the override files in ~/.flightcli/ over the
+// embedded airlines table. Problems are warnings: the embedded table still
+// works, and 'airline sources' lists them in full.
+func loadAirlineOverrides(cmd *cobra.Command) {
+	dir, err := airlines.DefaultOverrideDir()
+	if err != nil {
+		airlineOverrideErr = err
+		return
+	}
+	airlineOverrideDir = dir
+	files, err := airlines.LoadOverrides(dir)
+	airlineOverrideErr = err
+	if cmd == airlineSourcesCmd {
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+	for _, f := range files {
+		if len(f.Problems) > 0 {
+			fmt.Fprintf(os.Stderr, "Warning: skipped %d invalid airline %s in %s; run 'flightcli airline sources' for details\n",
+				len(f.Problems), pluralEntry(len(f.Problems)), f.Path)
+		}
+	}
+}
+
+type airlineInfo struct {
+	Name     string `json:"name"`
+	IATA     string `json:"iata"`
+	ICAO     string `json:"icao"`
+	Callsign string `json:"callsign,omitempty"`
+	Country  string `json:"country"`
+	Source   string `json:"source"`
+}
+
+func newAirlineInfo(a airlines.Airline) airlineInfo {
+	return airlineInfo{
+		Name:     a.Name,
+		IATA:     a.IATA,
+		ICAO:     a.ICAO,
+		Callsign: a.Callsign,
+		Country:  a.Country,
+		Source:   airlines.Source(a.ICAO),
+	}
+}
+
+func newAirlineInfos(list []airlines.Airline) []airlineInfo {
+	infos := make([]airlineInfo, len(list))
+	for i, a := range list {
+		infos[i] = newAirlineInfo(a)
+	}
+	return infos
+}
+
+// lookupAirlines returns the airline with an ICAO code, or every airline
+// sharing an IATA code.
+func lookupAirlines(input string) ([]airlines.Airline, error) {
+	code := strings.ToUpper(strings.TrimSpace(input))
+	switch len(code) {
+	case 3:
+		if a := airlines.ByICAO(code); a != nil {
+			return []airlines.Airline{*a}, nil
+		}
+	case 2:
+		if matches := airlines.AllByIATA(code); len(matches) > 0 {
+			return matches, nil
+		}
+	default:
+		return nil, fmt.Errorf("invalid airline code %q: use a 3-letter ICAO or 2-character IATA code", input)
+	}
+	return nil, fmt.Errorf("airline %q is not in the embedded airlines table: try 'flightcli airline search'", input)
+}
+
+func printAirlineInfo(info airlineInfo) {
+	fmt.Printf("Airline:  %s\n", info.Name)
+	fmt.Printf("IATA:     %s\n", info.IATA)
+	fmt.Printf("ICAO:     %s\n", info.ICAO)
+	if info.Callsign != "" {
+		fmt.Printf("Callsign: %s\n", info.Callsign)
+	}
+	fmt.Printf("Country:  %s\n", info.Country)
+	fmt.Printf("Source:   %s\n", info.Source)
+}
+
+func printAirlineTable(infos []airlineInfo) {
+	for _, info := range infos {
+		fmt.Printf("%-4s %-3s %-40s %-20s %s\n", info.ICAO, info.IATA, info.Name, info.Callsign, info.Country)
+	}
+}
+
+type airlineSourcesReport struct {
+	Dir      string              `json:"dir,omitempty"`
+	Embedded int                 `json:"embedded"`
+	BuiltIn  int                 `json:"built_in"`
+	Files    []airlineSourceFile `json:"files"`
+	Error    string              `json:"error,omitempty"`
+	Entries  []airlineInfo       `json:"entries"`
+}
+
+type airlineSourceFile struct {
+	Path     string   `json:"path"`
+	Entries  int      `json:"entries"`
+	Problems []string `json:"problems,omitempty"`
+}
+
+func newAirlineSourcesReport() airlineSourcesReport {
+	embedded, builtIn := airlines.Counts()
+	report := airlineSourcesReport{
+		Dir:      airlineOverrideDir,
+		Embedded: embedded,
+		BuiltIn:  builtIn,
+		Files:    []airlineSourceFile{},
+		Entries:  newAirlineInfos(airlines.Overridden()),
+	}
+	for _, f := range airlines.LoadedFiles() {
+		report.Files = append(report.Files, airlineSourceFile{Path: f.Path, Entries: f.Entries, Problems: f.Problems})
+	}
+	if airlineOverrideErr != nil {
+		report.Error = airlineOverrideErr.Error()
+	}
+	return report
+}
+
+func printAirlineSources(r airlineSourcesReport) {
+	fmt.Println("Airline data, later sources win:")
+	fmt.Println()
+	fmt.Printf("  Embedded OpenFlights data: %d airlines\n", r.Embedded)
+	fmt.Printf("  Built-in corrections:      %d airlines\n", r.BuiltIn)
+	for _, f := range r.Files {
+		fmt.Printf("  %s: %d merged, %d skipped\n", f.Path, f.Entries, len(f.Problems))
+		for _, problem := range f.Problems {
+			fmt.Printf("      %s\n", problem)
+		}
+	}
+	if r.Error != "" {
+		fmt.Printf("\nError: %s\n", r.Error)
+	}
+
+	if len(r.Files) == 0 {
+		if r.Dir != "" {
+			fmt.Printf("\nNo override files in %s. Add %s there to correct or extend the table.\n",
+				r.Dir, strings.Join(airlines.OverrideFiles, ", "))
+		}
+		return
+	}
+	if len(r.Entries) > 0 {
+		fmt.Println("\nFrom override files:")
+		fmt.Println()
+		for _, info := range r.Entries {
+			fmt.Printf("%-4s %-3s %-40s %s\n", info.ICAO, info.IATA, info.Name, info.Source)
+		}
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(airlineCmd)
+	airlineCmd.AddCommand(airlineSearchCmd)
+	airlineCmd.AddCommand(airlineSourcesCmd)
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/joshuachuah/flightcli/internal/airlines"
+)
+
+func TestLookupAirlinesReadsCodeLength(t *testing.T) {
+	united, err := lookupAirlines(" ual ")
+	if err != nil || len(united) != 1 || united[0].IATA != "UA" {
+		t.Fatalf("expected UAL to resolve to United, got %#v, %v", united, err)
+	}
+
+	shared, err := lookupAirlines("G8")
+	if err != nil || len(shared) != 4 {
+		t.Fatalf("expected every airline sharing G8, got %#v, %v", shared, err)
+	}
+
+	if _, err := lookupAirlines("UNITED"); err == nil {
+		t.Fatal("expected a name to be rejected as a code")
+	}
+	if _, err := lookupAirlines("QQQ"); err == nil {
+		t.Fatal("expected an unknown code to return an error")
+	}
+}
+
+func TestAirlineSourcesReportWithoutOverrideFiles(t *testing.T) {
+	if _, err := airlines.LoadOverrides(t.TempDir()); err != nil {
+		t.Fatalf("LoadOverrides returned error: %v", err)
+	}
+
+	report := newAirlineSourcesReport()
+	if report.Embedded == 0 || report.BuiltIn == 0 {
+		t.Fatalf("expected embedded and built-in counts, got %#v", report)
+	}
+	if len(report.Files) != 0 || len(report.Entries) != 0 {
+		t.Fatalf("expected no override files or entries, got %#v", report)
+	}
+
+	united := newAirlineInfo(*airlines.ByICAO("UAL"))
+	if united.Source != airlines.SourceEmbedded {
+		t.Fatalf("expected UAL to come from the embedded data, got %q", united.Source)
+	}
+}
@@ -9,19 +9,29 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/joshuachuah/flightcli/internal/display"
+	"github.com/joshuachuah/flightcli/internal/models"
 )
 
 var airportCmd = &cobra.Command{
 	Use:   "airport [airportCode]",
 	Short: "Get departures and arrivals for an airport",
-	Long:  `Display departure or arrival flights for a given airport IATA code (e.g. JFK, LAX, ORD).`,
+	Long: `Display departure or arrival flights for a given airport IATA code (e.g. JFK, LAX, ORD).
+
+Busy airports have more flights than one AviationStack request returns. Use
+--page to step through them, or --max and --window to fetch several pages at
+once; each page counts as one API call.
+
+--late-only keeps flights running at least 15 minutes behind schedule: the
+departure for departure boards, the arrival for arrival boards.
+
+Codeshares are folded into the flight that operates them, which lists their
+numbers. Use --show-codeshares to list each codeshare on its own row.
+
+Use 'airport info' and 'airport find' to look up an airport's details.`,
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		apiKey, err := requireAPIKey()
-		if err != nil {
-			printAPIKeyError()
-			cobra.CheckErr(err)
-		}
+		p, err := newProvider()
+		cobra.CheckErr(err)
 
 		airportCode, err := normalizeAirportCode(args[0], "airport code")
 		cobra.CheckErr(err)
@@ -31,7 +41,11 @@ var airportCmd = &cobra.Command{
 			cobra.CheckErr(fmt.Errorf("invalid --type %q: use 'departures' or 'arrivals'", flightType))
 		}
 
-		svc := newFlightService(apiKey, true)
+		opts, err := optionsFromFlags(cmd)
+		cobra.CheckErr(err)
+
+		svc := newFlightService(p, true)
+		svc.Options = opts
 
 		s := display.NewSpinner(fmt.Sprintf("Fetching %s for %s...", flightType, airportCode))
 		s.Start()
@@ -39,7 +53,14 @@ var airportCmd = &cobra.Command{
 		s.Stop()
 
 		if err != nil {
-			cobra.CheckErr(fmt.Errorf("fetching %s for %s: %w", flightType, airportCode, err))
+			checkProviderErr(fmt.Errorf("fetching %s for %s: %w", flightType, airportCode, err))
+		}
+
+		if showCodeshares, _ := cmd.Flags().GetBool("show-codeshares"); !showCodeshares {
+			flights = models.CollapseCodeshares(flights)
+		}
+		if lateOnly, _ := cmd.Flags().GetBool("late-only"); lateOnly {
+			flights = lateFlights(flights, flightType == "arrivals")
 		}
 
 		if jsonOutput {
@@ -48,13 +69,26 @@ var airportCmd = &cobra.Command{
 		}
 
 		display.PrintAirportFlights(flights, airportCode, flightType)
-		if cached {
-			display.PrintCachedIndicator()
-		}
+		display.PrintCachedIndicator(cached, boardSource(flights))
 	},
 }
 
+// lateFlights keeps the flights whose board-side leg is running late.
+func lateFlights(flights []models.AirportFlight, arrivals bool) []models.AirportFlight {
+	late := []models.AirportFlight{}
+	for _, f := range flights {
+		if f.Late(arrivals) {
+			late = append(late, f)
+		}
+	}
+	return late
+}
+
 func init() {
 	rootCmd.AddCommand(airportCmd)
 	airportCmd.Flags().StringP("type", "t", "departures", "Flight type: departures or arrivals")
+	airportCmd.Flags().Bool("late-only", false, "Only show flights running 15 or more minutes late")
+	airportCmd.Flags().Bool("show-codeshares", false, "List codeshares on their own rows instead of under the operating flight")
+	addPageFlags(airportCmd)
+	addDateFlag(airportCmd)
 }
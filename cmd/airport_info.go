@@ -0,0 +1,127 @@
+/*
+Copyright 2026 Joshua Chuah <jchuah07@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/airports"
+	"github.com/spf13/cobra"
+)
+
+var airportInfoCmd = &cobra.Command{
+	Use:   "info [airportCode]",
+	Short: "Show details for an airport",
+	Long: `Show an airport's name, city, country, ICAO code, coordinates, time zone
+and current local time. Accepts an IATA code (JFK) or an ICAO code (KJFK).
+
+Details come from the embedded airports table, which covers major commercial
+airports, so no API call is made.`,
+	Example: `  flightcli airport info JFK
+  flightcli airport info EGLL --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		a, err := lookupAirport(args[0])
+		cobra.CheckErr(err)
+
+		info := newAirportInfo(*a, time.Now())
+		if jsonOutput {
+			cobra.CheckErr(printJSONOutput(info))
+			return
+		}
+		printAirportInfo(info)
+	},
+}
+
+var airportFindCmd = &cobra.Command{
+	Use:   "find [query]",
+	Short: "Find airports by name, city or code",
+	Long: `Find airports in the embedded airports table whose name or city contains
+the query, or whose IATA or ICAO code matches it. Exact codes and cities are
+listed first.`,
+	Example: `  flightcli airport find heathrow
+  flightcli airport find "new york"`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		query := strings.Join(args, " ")
+		matches := airports.Search(query)
+		if len(matches) == 0 {
+			cobra.CheckErr(fmt.Errorf("no airports match %q", query))
+		}
+
+		now := time.Now()
+		infos := make([]airportInfo, len(matches))
+		for i, a := range matches {
+			infos[i] = newAirportInfo(a, now)
+		}
+		if jsonOutput {
+			cobra.CheckErr(printJSONOutput(infos))
+			return
+		}
+		for _, info := range infos {
+			fmt.Printf("%-4s %-5s %s, %s, %s\n", info.IATA, info.ICAO, info.Name, info.City, info.Country)
+		}
+	},
+}
+
+type airportInfo struct {
+	IATA      string    `json:"iata"`
+	ICAO      string    `json:"icao"`
+	Name      string    `json:"name"`
+	City      string    `json:"city"`
+	Country   string    `json:"country"`
+	Latitude  float64   `json:"latitude"`
+	Longitude float64   `json:"longitude"`
+	Timezone  string    `json:"timezone"`
+	LocalTime time.Time `json:"local_time"`
+}
+
+func newAirportInfo(a airports.Airport, now time.Time) airportInfo {
+	info := airportInfo{
+		IATA:      a.IATA,
+		ICAO:      a.ICAO,
+		Name:      a.Name,
+		City:      a.City,
+		Country:   a.Country,
+		Latitude:  a.Latitude,
+		Longitude: a.Longitude,
+		Timezone:  a.Timezone,
+		LocalTime: now.Truncate(time.Second),
+	}
+	if loc, err := a.Location(); err == nil {
+		info.LocalTime = info.LocalTime.In(loc)
+	}
+	return info
+}
+
+// lookupAirport finds an airport by IATA or ICAO code in the embedded table.
+func lookupAirport(input string) (*airports.Airport, error) {
+	code := strings.ToUpper(strings.TrimSpace(input))
+	if a := airports.ByIATA(code); a != nil {
+		return a, nil
+	}
+	if a := airports.ByICAO(code); a != nil {
+		return a, nil
+	}
+	if a := airports.Suggest(code); a != nil {
+		return nil, fmt.Errorf("unknown airport %q: did you mean %s (%s)?", input, a.IATA, a.Name)
+	}
+	return nil, fmt.Errorf("airport %q is not in the embedded airports table: try 'flightcli airport find'", input)
+}
+
+func printAirportInfo(info airportInfo) {
+	fmt.Printf("Airport:    %s (%s)\n", info.Name, info.IATA)
+	fmt.Printf("City:       %s, %s\n", info.City, info.Country)
+	fmt.Printf("ICAO:       %s\n", info.ICAO)
+	fmt.Printf("Location:   %.4f, %.4f\n", info.Latitude, info.Longitude)
+	fmt.Printf("Timezone:   %s (UTC%s)\n", info.Timezone, info.LocalTime.Format("-07:00"))
+	fmt.Printf("Local time: %s\n", info.LocalTime.Format("Mon, Jan 2 15:04 MST"))
+}
+
+func init() {
+	airportCmd.AddCommand(airportInfoCmd)
+	airportCmd.AddCommand(airportFindCmd)
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/models"
+)
+
+func TestLateFlightsUsesBoardSideDelay(t *testing.T) {
+	scheduled := time.Date(2026, time.March, 13, 14, 5, 0, 0, time.UTC)
+	leg := func(late time.Duration) models.LegDetails {
+		return models.LegDetails{Scheduled: scheduled, Estimated: scheduled.Add(late)}
+	}
+	flights := []models.AirportFlight{
+		{FlightNumber: "AA1", DepartureDetails: leg(35 * time.Minute), ArrivalDetails: leg(0)},
+		{FlightNumber: "AA2", DepartureDetails: leg(10 * time.Minute), ArrivalDetails: leg(20 * time.Minute)},
+		{FlightNumber: "AA3", DepartureDetails: models.LegDetails{Delay: 15}},
+		{FlightNumber: "AA4"},
+	}
+
+	for _, tt := range []struct {
+		arrivals bool
+		want     []string
+	}{
+		{false, []string{"AA1", "AA3"}},
+		{true, []string{"AA2"}},
+	} {
+		late := lateFlights(flights, tt.arrivals)
+		var got []string
+		for _, f := range late {
+			got = append(got, f.FlightNumber)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Fatalf("arrivals=%v: expected %v late, got %v", tt.arrivals, tt.want, got)
+		}
+	}
+}
+
+func TestAirportSubcommandsCoexistWithBoard(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{args: []string{"airport", "JFK", "--type", "arrivals"}, want: "airport"},
+		{args: []string{"airport", "info", "JFK"}, want: "info"},
+		{args: []string{"airport", "find", "heathrow"}, want: "find"},
+	}
+
+	for _, tt := range tests {
+		cmd, _, err := rootCmd.Find(tt.args)
+		if err != nil {
+			t.Fatalf("Find(%q) returned error: %v", tt.args, err)
+		}
+		if cmd.Name() != tt.want {
+			t.Errorf("Find(%q) = %q, want %q", tt.args, cmd.Name(), tt.want)
+		}
+	}
+}
+
+func TestLookupAirportAcceptsIATAAndICAO(t *testing.T) {
+	for _, code := range []string{"lhr", "EGLL"} {
+		a, err := lookupAirport(code)
+		if err != nil || a.IATA != "LHR" {
+			t.Fatalf("lookupAirport(%q) = %#v, %v", code, a, err)
+		}
+	}
+	if _, err := lookupAirport("JKF"); err == nil || err.Error() != `unknown airport "JKF": did you mean JFK (John F Kennedy International Airport)?` {
+		t.Fatalf("expected a JFK suggestion, got %v", err)
+	}
+}
+
+func TestNewAirportInfoUsesAirportLocalTime(t *testing.T) {
+	a, err := lookupAirport("NRT")
+	if err != nil {
+		t.Fatalf("lookupAirport returned error: %v", err)
+	}
+	now := time.Date(2026, time.March, 13, 23, 30, 15, 500, time.UTC)
+
+	info := newAirportInfo(*a, now)
+	if info.ICAO != "RJAA" || info.City != "Tokyo" || info.Timezone != "Asia/Tokyo" {
+		t.Fatalf("unexpected airport info: %#v", info)
+	}
+	if got := info.LocalTime.Format("2006-01-02 15:04:05 -07:00"); got != "2026-03-14 08:30:15 +09:00" {
+		t.Fatalf("expected Tokyo local time, got %s", got)
+	}
+}
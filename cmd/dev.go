@@ -0,0 +1,95 @@
+/*
+Copyright 2026 Joshua Chuah <jchuah07@gmail.com>
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/fakeserver"
+	"github.com/spf13/cobra"
+)
+
+var devCmd = &cobra.Command{
+	Use:   "dev",
+	Short: "Tools for developing and testing flightcli",
+}
+
+var fakeServerCmd = &cobra.Command{
+	Use:   "fake-server",
+	Short: "Serve a fake AviationStack API from local fixtures",
+	Long: `Serve /v1/flights and /v1/flightsFuture with the same JSON shape as
+AviationStack, answering from fixture files instead of the real API. Each
+*.json file in --fixtures holds either a full AviationStack response or an
+array of rows: timetable rows for files named schedules*.json, flights for
+the rest. Without --fixtures a small built-in set is served.
+
+The flight_iata, flight_icao, dep_iata, arr_iata, flight_date, limit and
+offset query parameters are honored; the built-in flights are dated
+2026-03-13. flightsFuture answers iataCode, type and date queries with the
+same weekly timetable for any date.
+
+--status and --delay inject rate limits, server errors and slow responses,
+on every request or every Nth with --every.
+
+Point the CLI at it with --aviationstack-url:
+
+  flightcli dev fake-server --status 429 --every 3
+  AVIATIONSTACK_API_KEY=test flightcli status UA2189 --aviationstack-url http://localhost:8099/v1/flights`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		addr, _ := cmd.Flags().GetString("addr")
+		fixtures, _ := cmd.Flags().GetString("fixtures")
+		status, _ := cmd.Flags().GetInt("status")
+		delay, _ := cmd.Flags().GetDuration("delay")
+		every, _ := cmd.Flags().GetInt("every")
+
+		if status != 0 && status != http.StatusTooManyRequests && status != http.StatusInternalServerError {
+			cobra.CheckErr(fmt.Errorf("invalid --status %d: use 429 or 500", status))
+		}
+		if delay < 0 || every < 0 {
+			cobra.CheckErr(fmt.Errorf("--delay and --every cannot be negative"))
+		}
+
+		server, err := fakeserver.New(fixtures)
+		cobra.CheckErr(err)
+		server.Faults = fakeserver.Faults{Status: status, Delay: delay, Every: every}
+
+		listener, err := net.Listen("tcp", addr)
+		cobra.CheckErr(err)
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		httpServer := &http.Server{Handler: server, ReadHeaderTimeout: 10 * time.Second}
+		go func() {
+			<-ctx.Done()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = httpServer.Shutdown(shutdownCtx)
+		}()
+
+		fmt.Printf("Serving %d fixture flights on http://%s/v1/flights (Ctrl+C to stop)\n", len(server.Flights), listener.Addr())
+		if err := httpServer.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+			cobra.CheckErr(err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(devCmd)
+	devCmd.AddCommand(fakeServerCmd)
+	fakeServerCmd.Flags().String("addr", "localhost:8099", "Address to listen on")
+	fakeServerCmd.Flags().String("fixtures", "", "Directory of *.json fixtures (default: built-in sample flights)")
+	fakeServerCmd.Flags().Int("status", 0, "Inject this error status instead of data: 429 or 500")
+	fakeServerCmd.Flags().Duration("delay", 0, "Delay responses by this long, e.g. 5s")
+	fakeServerCmd.Flags().Int("every", 1, "Inject --status/--delay only on every Nth request")
+}
@@ -8,11 +8,17 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/joshuachuah/flightcli/internal/airports"
 	"github.com/joshuachuah/flightcli/internal/cache"
+	"github.com/joshuachuah/flightcli/internal/display"
+	"github.com/joshuachuah/flightcli/internal/models"
 	"github.com/joshuachuah/flightcli/internal/provider"
 	"github.com/joshuachuah/flightcli/internal/service"
+	"github.com/spf13/cobra"
 )
 
 var airportCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
@@ -30,6 +36,26 @@ func printAPIKeyError() {
 	fmt.Fprintln(os.Stderr, "Get a free key at https://aviationstack.com/")
 }
 
+// checkProviderErr exits like cobra.CheckErr, adding remediation steps when
+// the error is one the user can fix, such as an invalid key or spent quota.
+func checkProviderErr(err error) {
+	if err == nil {
+		return
+	}
+	printProviderErr(err)
+	os.Exit(1)
+}
+
+func printProviderErr(err error) {
+	fmt.Fprintln(os.Stderr, "Error:", err)
+	if help := display.ErrorHelp(err); len(help) > 0 {
+		fmt.Fprintln(os.Stderr)
+		for _, line := range help {
+			fmt.Fprintln(os.Stderr, "  "+line)
+		}
+	}
+}
+
 func requireAPIKey() (string, error) {
 	apiKey := os.Getenv("AVIATIONSTACK_API_KEY")
 	if apiKey == "" {
@@ -38,7 +64,14 @@ func requireAPIKey() (string, error) {
 	return apiKey, nil
 }
 
-func newFlightService(apiKey string, useCache bool) service.FlightService {
+func newFlightService(p provider.FlightProvider, useCache bool) service.FlightService {
+	// A local receiver is free to query and always fresher than the cache.
+	// Recording needs every request to reach the provider, and replay must
+	// answer from the recordings alone.
+	if usesLocalReceiver() || recordDir != "" || replayDir != "" {
+		useCache = false
+	}
+
 	var c *cache.Cache
 	if useCache {
 		created, err := cache.New()
@@ -49,10 +82,24 @@ func newFlightService(apiKey string, useCache bool) service.FlightService {
 		}
 	}
 
-	return service.FlightService{
-		Provider: &provider.AviationStackProvider{APIKey: apiKey},
-		Cache:    c,
+	svc := service.FlightService{
+		Provider:   p,
+		Cache:      c,
+		CacheScope: providerCacheScope(),
+	}
+	if slices.Contains(selectedProviderNames(), "aviationstack") && replayDir == "" {
+		svc.Quota = usageBudget()
+	}
+	return svc
+}
+
+// boardSource returns the provider that answered a board or search, which
+// is the same for every row.
+func boardSource(flights []models.AirportFlight) string {
+	if len(flights) == 0 {
+		return ""
 	}
+	return flights[0].Source
 }
 
 func printJSONOutput(v interface{}) error {
@@ -69,5 +116,85 @@ func normalizeAirportCode(input, fieldName string) (string, error) {
 	if !airportCodePattern.MatchString(code) {
 		return "", fmt.Errorf("invalid %s %q: use a 3-letter IATA airport code", fieldName, input)
 	}
+	if airports.Unknown(code) {
+		if a := airports.Suggest(code); a != nil {
+			return "", fmt.Errorf("unknown %s %q: did you mean %s (%s)?", fieldName, input, a.IATA, a.Name)
+		}
+		return "", fmt.Errorf("unknown %s %q: try 'flightcli airport find' to look up its code", fieldName, input)
+	}
 	return code, nil
 }
+
+// flightNumberFromArgs joins the arguments of status and track, so a radio
+// callsign can be given unquoted: flightcli status SPEEDBIRD 12.
+func flightNumberFromArgs(args []string) (string, error) {
+	flightNumber := strings.Join(args, " ")
+	if err := provider.CheckFlightNumber(flightNumber); err != nil {
+		return "", err
+	}
+	return flightNumber, nil
+}
+
+// addPageFlags adds the pagination flags shared by airport, search and
+// schedule.
+func addPageFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("limit", 0, fmt.Sprintf("Flights per AviationStack request, up to %d (default %d)", provider.AviationStackMaxPageSize, provider.AviationStackMaxPageSize))
+	cmd.Flags().Int("page", 1, "Page of results to start from, counting pages of --limit flights")
+	cmd.Flags().Int("max", 0, "Keep fetching pages until this many flights are shown")
+	cmd.Flags().Duration("window", 0, "Keep fetching pages until flights this far ahead are shown, e.g. 3h")
+}
+
+// addDateFlag adds --date to a lookup command.
+func addDateFlag(cmd *cobra.Command) {
+	cmd.Flags().String("date", "", "Look up flights on this day (YYYY-MM-DD) instead of today")
+}
+
+// optionsFromFlags reads the flags added by addDateFlag and, where the
+// command has them, addPageFlags.
+func optionsFromFlags(cmd *cobra.Command) (provider.Options, error) {
+	var opts provider.Options
+	if value, _ := cmd.Flags().GetString("date"); value != "" {
+		date, err := time.ParseInLocation(provider.DateLayout, strings.TrimSpace(value), time.Local)
+		if err != nil {
+			return opts, fmt.Errorf("invalid --date %q: use YYYY-MM-DD", value)
+		}
+		opts.Date = date
+	}
+	if cmd.Flags().Lookup("limit") == nil {
+		return opts, nil
+	}
+
+	page, err := pageFromFlags(cmd)
+	if err != nil {
+		return opts, err
+	}
+	if page.Window > 0 && !opts.Date.IsZero() {
+		return opts, fmt.Errorf("--window counts from now and cannot be combined with --date")
+	}
+	opts.Page = page
+	return opts, nil
+}
+
+// pageFromFlags reads the flags added by addPageFlags.
+func pageFromFlags(cmd *cobra.Command) (provider.Page, error) {
+	limit, _ := cmd.Flags().GetInt("limit")
+	number, _ := cmd.Flags().GetInt("page")
+	maxFlights, _ := cmd.Flags().GetInt("max")
+	window, _ := cmd.Flags().GetDuration("window")
+
+	if limit < 0 || limit > provider.AviationStackMaxPageSize {
+		return provider.Page{}, fmt.Errorf("invalid --limit %d: use 1 to %d", limit, provider.AviationStackMaxPageSize)
+	}
+	if number < 1 {
+		return provider.Page{}, fmt.Errorf("invalid --page %d: pages start at 1", number)
+	}
+	if maxFlights < 0 || window < 0 {
+		return provider.Page{}, fmt.Errorf("--max and --window cannot be negative")
+	}
+
+	page := provider.Page{Size: limit, Max: maxFlights, Window: window}
+	if number > 1 {
+		page.Number = number
+	}
+	return page, nil
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -61,3 +62,13 @@ func TestNormalizeAirportCodeRejectsInvalidInput(t *testing.T) {
 		t.Fatalf("expected invalid airport code to return an error")
 	}
 }
+
+func TestNormalizeAirportCodeAcceptsAirportsMissingFromTable(t *testing.T) {
+	// ALS looks like LAS with two letters swapped, but is Alamosa, Colorado.
+	for _, code := range []string{"als", "LSC", "OBS", "ASN", "ALX", "ROD"} {
+		got, err := normalizeAirportCode(code, "--from")
+		if err != nil || got != strings.ToUpper(code) {
+			t.Errorf("normalizeAirportCode(%q) = %q, %v", code, got, err)
+		}
+	}
+}
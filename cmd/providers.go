@@ -0,0 +1,302 @@
+/*
+Copyright 2026 Joshua Chuah <jchuah07@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/provider"
+	"github.com/joshuachuah/flightcli/internal/quota"
+	"github.com/joshuachuah/flightcli/internal/ratelimit"
+)
+
+var (
+	providerName         string
+	providerCooldown     time.Duration
+	aviationStackURL     string
+	aviationStackTimeout time.Duration
+	aviationStackCAFile  string
+	aviationStackProxy   string
+	aviationStackRetries int
+	debugLogging         bool
+	quotaLimit           int
+	quotaHardLimit       bool
+	rateLimit            int
+	openSkyURL           string
+	adsbSource           string
+	sbsAddr              string
+	recordDir            string
+	replayDir            string
+)
+
+// newProvider builds the flight data provider selected with --provider or
+// FLIGHTCLI_PROVIDER. A comma-separated list builds a fallback chain that
+// tries each provider in order. AviationStack is the default and the only
+// provider that needs an API key.
+func newProvider() (provider.FlightProvider, error) {
+	transport, err := providerTransport()
+	if err != nil {
+		return nil, err
+	}
+
+	names := selectedProviderNames()
+	if len(names) == 1 {
+		return newNamedProvider(names[0], transport)
+	}
+
+	chain := &provider.ChainProvider{Cooldown: providerCooldown}
+	for _, name := range names {
+		p, err := newNamedProvider(name, transport)
+		if err != nil {
+			return nil, err
+		}
+		chain.Providers = append(chain.Providers, provider.NamedProvider{Name: name, Provider: p})
+	}
+	return chain, nil
+}
+
+func newNamedProvider(name string, transport http.RoundTripper) (provider.FlightProvider, error) {
+	switch name {
+	case "aviationstack":
+		return newAviationStackProvider(transport)
+	case "opensky":
+		return &provider.OpenSkyProvider{BaseURL: flagOrEnv(openSkyURL, "OPENSKY_BASE_URL"), Transport: transport}, nil
+	case "adsb":
+		return &provider.ADSBProvider{Source: flagOrEnv(adsbSource, "ADSB_SOURCE"), Transport: transport}, nil
+	case "sbs":
+		if transport != nil {
+			return nil, fmt.Errorf("--record and --replay are not supported by the sbs provider")
+		}
+		return &provider.SBSProvider{Addr: flagOrEnv(sbsAddr, "SBS_ADDR")}, nil
+	default:
+		return nil, fmt.Errorf("unknown provider %q: use aviationstack, opensky, adsb, or sbs", name)
+	}
+}
+
+func newAviationStackProvider(transport http.RoundTripper) (*provider.AviationStackProvider, error) {
+	apiKey, err := requireAPIKey()
+	if replayDir != "" && err != nil {
+		// Recordings never contain the key, so replay works without one.
+		apiKey, err = "replay", nil
+	}
+	if err != nil {
+		printAPIKeyError()
+		return nil, err
+	}
+
+	timeout := aviationStackTimeout
+	if timeout == 0 {
+		if value := os.Getenv("AVIATIONSTACK_TIMEOUT"); value != "" {
+			timeout, err = time.ParseDuration(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid AVIATIONSTACK_TIMEOUT %q: use a duration such as 30s", value)
+			}
+		}
+	}
+	if timeout < 0 {
+		return nil, fmt.Errorf("AviationStack timeout cannot be negative")
+	}
+	if aviationStackRetries < 0 {
+		return nil, fmt.Errorf("--aviationstack-retries cannot be negative")
+	}
+
+	p := &provider.AviationStackProvider{
+		APIKey:     apiKey,
+		Endpoint:   flagOrEnv(aviationStackURL, "AVIATIONSTACK_URL"),
+		Timeout:    timeout,
+		Transport:  transport,
+		CAFile:     flagOrEnv(aviationStackCAFile, "AVIATIONSTACK_CA_FILE"),
+		ProxyURL:   flagOrEnv(aviationStackProxy, "AVIATIONSTACK_PROXY"),
+		MaxRetries: aviationStackRetries,
+		Logger:     debugLogger(),
+	}
+	if replayDir == "" {
+		// Replayed responses cost nothing, so only real calls are counted.
+		p.Quota = usageBudget()
+
+		rpm, err := requestsPerMinute()
+		if err != nil {
+			return nil, err
+		}
+		if rpm > 0 {
+			limiter, err := ratelimit.Open(apiKey, rpm)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: rate limiting disabled: %v\n", err)
+			}
+			p.RateLimiter = limiter
+		}
+	}
+
+	switch t := transport.(type) {
+	case *provider.ReplayTransport:
+		// Replay never reaches the network.
+		p.CAFile, p.ProxyURL = "", ""
+	case *provider.RecordingTransport:
+		if p.CAFile != "" || p.ProxyURL != "" {
+			// Record through the proxy and CA bundle rather than around them.
+			next, err := provider.NewHTTPTransport(p.CAFile, p.ProxyURL)
+			if err != nil {
+				return nil, err
+			}
+			p.Transport = &provider.RecordingTransport{Dir: t.Dir, Next: next}
+			p.CAFile, p.ProxyURL = "", ""
+		}
+	}
+
+	if p.SendsKeyInClear() {
+		fmt.Fprintln(os.Stderr, "Warning: the AviationStack endpoint uses plain http; your API key is sent unencrypted.")
+	}
+	return p, nil
+}
+
+var (
+	budgetOnce sync.Once
+	budget     *quota.Budget
+)
+
+// usageBudget returns the shared AviationStack call budget, or nil if the
+// usage ledger cannot be opened. Its limit comes from --quota-limit, else
+// AVIATIONSTACK_MONTHLY_LIMIT, else the free plan's allowance.
+func usageBudget() *quota.Budget {
+	budgetOnce.Do(func() {
+		ledger, err := quota.Open()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: API usage tracking disabled: %v\n", err)
+			return
+		}
+		budget = &quota.Budget{
+			Ledger: ledger,
+			Limit:  monthlyLimit(),
+			Hard:   quotaHardLimit,
+			Warn: func(message string) {
+				fmt.Fprintf(os.Stderr, "Warning: %s\n", message)
+			},
+		}
+	})
+	return budget
+}
+
+// monthlyLimit resolves the call allowance; zero means unlimited.
+func monthlyLimit() int {
+	if quotaLimit != 0 {
+		return max(quotaLimit, 0)
+	}
+	if value := os.Getenv("AVIATIONSTACK_MONTHLY_LIMIT"); value != "" {
+		if limit, err := strconv.Atoi(value); err == nil {
+			return max(limit, 0)
+		}
+		fmt.Fprintf(os.Stderr, "Warning: ignoring invalid AVIATIONSTACK_MONTHLY_LIMIT %q\n", value)
+	}
+	return quota.DefaultMonthlyLimit
+}
+
+// requestsPerMinute resolves the AviationStack rate limit from
+// --rate-limit, else AVIATIONSTACK_RATE_LIMIT, else ratelimit.DefaultRPM.
+// Zero means no limit.
+func requestsPerMinute() (int, error) {
+	if rateLimit != 0 {
+		return max(rateLimit, 0), nil
+	}
+	if value := os.Getenv("AVIATIONSTACK_RATE_LIMIT"); value != "" {
+		rpm, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid AVIATIONSTACK_RATE_LIMIT %q: use requests per minute, or -1 for no limit", value)
+		}
+		return max(rpm, 0), nil
+	}
+	return ratelimit.DefaultRPM, nil
+}
+
+// debugLogger returns the logger for --debug messages, or nil when debug
+// output is off.
+func debugLogger() *log.Logger {
+	if !debugLogging {
+		return nil
+	}
+	return log.New(os.Stderr, "debug: ", log.Ltime|log.Lmicroseconds)
+}
+
+// flagOrEnv returns the flag value, falling back to the environment
+// variable when the flag was not set.
+func flagOrEnv(value, env string) string {
+	if value != "" {
+		return value
+	}
+	return os.Getenv(env)
+}
+
+// providerTransport returns the transport for --record or --replay, or nil
+// to talk to providers directly.
+func providerTransport() (http.RoundTripper, error) {
+	switch {
+	case recordDir != "" && replayDir != "":
+		return nil, fmt.Errorf("--record and --replay cannot be used together")
+	case recordDir != "":
+		return &provider.RecordingTransport{Dir: recordDir}, nil
+	case replayDir != "":
+		if info, err := os.Stat(replayDir); err != nil || !info.IsDir() {
+			return nil, fmt.Errorf("replay directory %q does not exist", replayDir)
+		}
+		return &provider.ReplayTransport{Dir: replayDir}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// selectedProviderNames returns the configured providers in fallback order,
+// lower-cased and without duplicates.
+func selectedProviderNames() []string {
+	value := providerName
+	if value == "" {
+		value = os.Getenv("FLIGHTCLI_PROVIDER")
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return []string{"aviationstack"}
+	}
+	return names
+}
+
+func usesLocalReceiver() bool {
+	for _, name := range selectedProviderNames() {
+		if name == "adsb" || name == "sbs" {
+			return true
+		}
+	}
+	return false
+}
+
+// providerCacheScope keeps cache entries from different providers apart,
+// and answers from a custom AviationStack endpoint (such as the fake
+// server) apart from the real API. The default AviationStack setup keeps
+// the unscoped keys so existing entries stay valid.
+func providerCacheScope() string {
+	names := selectedProviderNames()
+	scope := strings.Join(names, ",")
+	if endpoint := flagOrEnv(aviationStackURL, "AVIATIONSTACK_URL"); endpoint != "" && slices.Contains(names, "aviationstack") {
+		scope += "@" + endpoint
+	}
+	if scope == "aviationstack" {
+		return ""
+	}
+	return scope
+}
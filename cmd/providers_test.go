@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/joshuachuah/flightcli/internal/provider"
+)
+
+func TestNewProviderSelectsOpenSkyWithoutAPIKey(t *testing.T) {
+	original := providerName
+	t.Cleanup(func() { providerName = original })
+	providerName = "OpenSky"
+
+	p, err := newProvider()
+	if err != nil {
+		t.Fatalf("newProvider returned error: %v", err)
+	}
+	if _, ok := p.(*provider.OpenSkyProvider); !ok {
+		t.Fatalf("expected OpenSkyProvider, got %T", p)
+	}
+}
+
+func TestNewProviderRejectsUnknownProvider(t *testing.T) {
+	original := providerName
+	t.Cleanup(func() { providerName = original })
+	providerName = "flightradar"
+
+	if _, err := newProvider(); err == nil {
+		t.Fatal("expected unknown provider to return an error")
+	}
+}
+
+func TestNewProviderBuildsChainFromList(t *testing.T) {
+	original := providerName
+	t.Cleanup(func() { providerName = original })
+	providerName = "opensky, adsb,opensky"
+
+	p, err := newProvider()
+	if err != nil {
+		t.Fatalf("newProvider returned error: %v", err)
+	}
+	chain, ok := p.(*provider.ChainProvider)
+	if !ok {
+		t.Fatalf("expected ChainProvider, got %T", p)
+	}
+	if len(chain.Providers) != 2 || chain.Providers[0].Name != "opensky" || chain.Providers[1].Name != "adsb" {
+		t.Fatalf("unexpected chain members: %#v", chain.Providers)
+	}
+	if scope := providerCacheScope(); scope != "opensky,adsb" {
+		t.Fatalf("expected cache scope opensky,adsb, got %q", scope)
+	}
+	if !usesLocalReceiver() {
+		t.Fatal("expected a chain containing adsb to count as a local receiver")
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 Joshua Chuah <jchuah07@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/quota"
+	"github.com/spf13/cobra"
+)
+
+var quotaCmd = &cobra.Command{
+	Use:   "quota",
+	Short: "Show AviationStack API usage for this month",
+	Long: `Show how many AviationStack API calls have been made this month, how many
+lookups the cache answered instead, and how many calls the plan has left.
+
+Usage is counted locally in ~/.flightcli/usage.jsonl, so calls made with the
+same key from other machines are not included. The monthly limit comes from
+--quota-limit or AVIATIONSTACK_MONTHLY_LIMIT and defaults to the free plan's
+100 calls.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ledger, err := quota.Open()
+		cobra.CheckErr(err)
+
+		now := time.Now()
+		usage, err := ledger.Usage(quota.MonthStart(now))
+		if err != nil {
+			cobra.CheckErr(fmt.Errorf("reading API usage: %w", err))
+		}
+		report := newQuotaReport(usage, monthlyLimit())
+
+		if jsonOutput {
+			cobra.CheckErr(printJSONOutput(report))
+			return
+		}
+		printQuotaReport(report)
+	},
+}
+
+type quotaReport struct {
+	Calls     int        `json:"calls"`
+	Limit     int        `json:"limit,omitempty"`
+	Remaining *int       `json:"remaining,omitempty"`
+	CacheHits int        `json:"cache_hits"`
+	LastCall  *time.Time `json:"last_call,omitempty"`
+	Resets    time.Time  `json:"resets"`
+}
+
+func newQuotaReport(usage quota.Usage, limit int) quotaReport {
+	report := quotaReport{
+		Calls:     usage.Calls,
+		CacheHits: usage.CacheHits,
+		Resets:    usage.Since.AddDate(0, 1, 0),
+	}
+	if limit > 0 {
+		remaining := max(limit-usage.Calls, 0)
+		report.Limit = limit
+		report.Remaining = &remaining
+	}
+	if !usage.LastCall.IsZero() {
+		last := usage.LastCall.Local()
+		report.LastCall = &last
+	}
+	return report
+}
+
+func printQuotaReport(r quotaReport) {
+	if r.Limit > 0 {
+		fmt.Printf("API calls this month: %d of %d\n", r.Calls, r.Limit)
+		fmt.Printf("Remaining:            %d\n", *r.Remaining)
+	} else {
+		fmt.Printf("API calls this month: %d (no limit set)\n", r.Calls)
+	}
+	fmt.Printf("Cache hits:           %d\n", r.CacheHits)
+	if r.LastCall != nil {
+		fmt.Printf("Last call:            %s\n", r.LastCall.Format("Jan 2 15:04"))
+	}
+	fmt.Printf("Resets:               %s\n", r.Resets.Format("Jan 2, 2006"))
+	if r.Limit > 0 && r.Calls > r.Limit {
+		fmt.Printf("\nOver budget by %d calls. AviationStack may start refusing requests.\n", r.Calls-r.Limit)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(quotaCmd)
+}
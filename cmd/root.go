@@ -6,7 +6,9 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/joshuachuah/flightcli/internal/provider"
 	"github.com/joshuachuah/flightcli/internal/tui"
 	"github.com/spf13/cobra"
 )
@@ -22,7 +24,13 @@ departure and arrival boards, track flights in real-time, and search
 routes between airports.
 
 Requires an AviationStack API key set via the AVIATIONSTACK_API_KEY
-environment variable or a .env file in the current directory.`,
+environment variable or a .env file in the current directory. Use
+--provider opensky to query the OpenSky Network instead, --provider adsb
+to read a local dump1090/readsb receiver, or --provider sbs to follow its
+SBS-1 feed; none of these need a key.`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		loadAirlineOverrides(cmd)
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		cobra.CheckErr(runTUI(cmd))
 	},
@@ -39,18 +47,41 @@ func runTUI(cmd *cobra.Command) error {
 	if jsonOutput {
 		return fmt.Errorf("--json is only supported with a command such as status, airport, or search")
 	}
+	if debugLogging {
+		return fmt.Errorf("--debug is only supported with a command such as status, airport, or search")
+	}
 
-	apiKey, err := requireAPIKey()
+	p, err := newProvider()
 	if err != nil {
-		printAPIKeyError()
 		return err
 	}
 
-	svc := newFlightService(apiKey, true)
+	svc := newFlightService(p, true)
+	if svc.Quota != nil {
+		// The status bar shows the remaining calls; stderr would garble
+		// the screen.
+		svc.Quota.Warn = nil
+	}
 	return tui.Launch(cmd.Context(), svc)
 }
 
 func init() {
 	rootCmd.PersistentFlags().BoolVar(&jsonOutput, "json", false, "Output results as JSON")
+	rootCmd.PersistentFlags().BoolVar(&debugLogging, "debug", false, "Log provider retries and other diagnostics to stderr")
+	rootCmd.PersistentFlags().StringVar(&providerName, "provider", "", "Flight data provider: aviationstack, opensky, adsb, or sbs; a comma-separated list falls back in order (default from FLIGHTCLI_PROVIDER, else aviationstack)")
+	rootCmd.PersistentFlags().DurationVar(&providerCooldown, "provider-cooldown", 5*time.Minute, "How long a failing provider in a fallback list is skipped")
+	rootCmd.PersistentFlags().StringVar(&aviationStackURL, "aviationstack-url", "", "AviationStack flights endpoint (default from AVIATIONSTACK_URL, else "+provider.DefaultAviationStackEndpoint+")")
+	rootCmd.PersistentFlags().DurationVar(&aviationStackTimeout, "aviationstack-timeout", 0, "AviationStack request timeout (default from AVIATIONSTACK_TIMEOUT, else 15s)")
+	rootCmd.PersistentFlags().IntVar(&aviationStackRetries, "aviationstack-retries", 3, "Retries for AviationStack network errors, 5xx and 429 responses")
+	rootCmd.PersistentFlags().IntVar(&quotaLimit, "quota-limit", 0, "AviationStack calls allowed per month, or -1 for no limit (default from AVIATIONSTACK_MONTHLY_LIMIT, else 100)")
+	rootCmd.PersistentFlags().BoolVar(&quotaHardLimit, "hard-limit", false, "Refuse AviationStack calls beyond --quota-limit instead of warning")
+	rootCmd.PersistentFlags().IntVar(&rateLimit, "rate-limit", 0, "AviationStack requests per minute shared by all flightcli processes, or -1 for no limit (default from AVIATIONSTACK_RATE_LIMIT, else 60)")
+	rootCmd.PersistentFlags().StringVar(&aviationStackCAFile, "aviationstack-ca-file", "", "Extra PEM CA bundle to trust for AviationStack (default from AVIATIONSTACK_CA_FILE)")
+	rootCmd.PersistentFlags().StringVar(&aviationStackProxy, "aviationstack-proxy", "", "Proxy URL for AviationStack requests (default from AVIATIONSTACK_PROXY, else HTTPS_PROXY)")
+	rootCmd.PersistentFlags().StringVar(&openSkyURL, "opensky-url", "", "OpenSky API base URL (default from OPENSKY_BASE_URL, else the public API)")
+	rootCmd.PersistentFlags().StringVar(&adsbSource, "adsb-source", "", "dump1090 aircraft.json path or URL (default from ADSB_SOURCE, else "+provider.DefaultADSBSource+")")
+	rootCmd.PersistentFlags().StringVar(&sbsAddr, "sbs-addr", "", "SBS-1 BaseStation feed host:port (default from SBS_ADDR, else "+provider.DefaultSBSAddr+")")
+	rootCmd.PersistentFlags().StringVar(&recordDir, "record", "", "Record every provider response to this directory")
+	rootCmd.PersistentFlags().StringVar(&replayDir, "replay", "", "Answer from responses recorded with --record instead of the network")
 	rootCmd.AddCommand(versionCmd)
 }
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 Joshua Chuah <jchuah07@gmail.com>
+*/
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/display"
+	"github.com/joshuachuah/flightcli/internal/provider"
+	"github.com/spf13/cobra"
+)
+
+var (
+	scheduleFrom string
+	scheduleTo   string
+	scheduleDate string
+)
+
+var scheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Show the timetable between two airports on a future date",
+	Long: `Show the flights scheduled between two airports on a future date, with
+departure and arrival times local to each airport. Codeshares list the
+airline that actually operates the flight.
+
+Timetables come from AviationStack's future schedules, which start a week
+ahead and may need a paid plan. AviationStack lists schedules by departure
+airport, so the route is picked out of the airport's departures. One API
+call fetches one page of up to 100 departures; at a busy airport the route
+may be on a later page. Use --page to step through them, or --max to fetch
+several pages at once, each costing one API call.
+
+For flights today or in the past, use 'search' (with --date).`,
+	Example: `  flightcli schedule --from JFK --to LAX --date 2026-12-20
+  flightcli schedule --from JFK --to LAX --date 2026-12-20 --max 300`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		p, err := newProvider()
+		cobra.CheckErr(err)
+
+		from, err := normalizeAirportCode(scheduleFrom, "--from")
+		cobra.CheckErr(err)
+		to, err := normalizeAirportCode(scheduleTo, "--to")
+		cobra.CheckErr(err)
+		date, err := time.ParseInLocation(provider.DateLayout, strings.TrimSpace(scheduleDate), time.Local)
+		if err != nil {
+			cobra.CheckErr(fmt.Errorf("invalid --date %q: use YYYY-MM-DD", scheduleDate))
+		}
+		if provider.BeforeToday(date, time.Now()) {
+			cobra.CheckErr(fmt.Errorf("--date %s is in the past: use 'search --date' for past flights", scheduleDate))
+		}
+		page, err := pageFromFlags(cmd)
+		cobra.CheckErr(err)
+		if page.Window > 0 {
+			cobra.CheckErr(fmt.Errorf("--window is not supported with schedule: use --max"))
+		}
+		svc := newFlightService(p, true)
+		svc.Options.Page = page
+
+		s := display.NewSpinner(fmt.Sprintf("Fetching schedule from %s to %s...", from, to))
+		s.Start()
+		flights, cached, err := svc.GetSchedule(cmd.Context(), from, to, date)
+		s.Stop()
+
+		if err != nil {
+			checkProviderErr(fmt.Errorf("fetching schedule from %s to %s: %w", from, to, err))
+		}
+
+		if jsonOutput {
+			cobra.CheckErr(printJSONOutput(flights))
+			return
+		}
+
+		display.PrintSchedule(flights, from, to, date.Format(provider.DateLayout))
+		source := ""
+		if len(flights) > 0 {
+			source = flights[0].Source
+		}
+		display.PrintCachedIndicator(cached, source)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(scheduleCmd)
+	scheduleCmd.Flags().StringVar(&scheduleFrom, "from", "", "Departure airport IATA code (e.g. JFK)")
+	scheduleCmd.Flags().StringVar(&scheduleTo, "to", "", "Arrival airport IATA code (e.g. LAX)")
+	scheduleCmd.Flags().StringVar(&scheduleDate, "date", "", "Day to show, as YYYY-MM-DD")
+	scheduleCmd.MarkFlagRequired("from")
+	scheduleCmd.MarkFlagRequired("to")
+	scheduleCmd.MarkFlagRequired("date")
+	addPageFlags(scheduleCmd)
+	// --window counts from now, which means nothing for a future date.
+	scheduleCmd.Flags().MarkHidden("window")
+}
@@ -20,17 +20,17 @@ var searchCmd = &cobra.Command{
 	Short: "Search flights between two airports",
 	Long:  `Search for current flights on a specific route using IATA airport codes.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		apiKey, err := requireAPIKey()
-		if err != nil {
-			printAPIKeyError()
-			cobra.CheckErr(err)
-		}
+		p, err := newProvider()
+		cobra.CheckErr(err)
 
 		from, err := normalizeAirportCode(searchFrom, "--from")
 		cobra.CheckErr(err)
 		to, err := normalizeAirportCode(searchTo, "--to")
 		cobra.CheckErr(err)
-		svc := newFlightService(apiKey, true)
+		opts, err := optionsFromFlags(cmd)
+		cobra.CheckErr(err)
+		svc := newFlightService(p, true)
+		svc.Options = opts
 
 		s := display.NewSpinner(fmt.Sprintf("Searching flights from %s to %s...", from, to))
 		s.Start()
@@ -38,7 +38,7 @@ var searchCmd = &cobra.Command{
 		s.Stop()
 
 		if err != nil {
-			cobra.CheckErr(fmt.Errorf("searching flights from %s to %s: %w", from, to, err))
+			checkProviderErr(fmt.Errorf("searching flights from %s to %s: %w", from, to, err))
 		}
 
 		if jsonOutput {
@@ -47,9 +47,7 @@ var searchCmd = &cobra.Command{
 		}
 
 		display.PrintSearchResults(flights, from, to)
-		if cached {
-			display.PrintCachedIndicator()
-		}
+		display.PrintCachedIndicator(cached, boardSource(flights))
 	},
 }
 
@@ -57,6 +55,8 @@ func init() {
 	rootCmd.AddCommand(searchCmd)
 	searchCmd.Flags().StringVar(&searchFrom, "from", "", "Departure airport IATA code (e.g. JFK)")
 	searchCmd.Flags().StringVar(&searchTo, "to", "", "Arrival airport IATA code (e.g. LAX)")
+	addPageFlags(searchCmd)
+	addDateFlag(searchCmd)
 	searchCmd.MarkFlagRequired("from")
 	searchCmd.MarkFlagRequired("to")
 }
@@ -5,8 +5,11 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/joshuachuah/flightcli/internal/airlines"
 	"github.com/joshuachuah/flightcli/internal/display"
 )
 
@@ -16,17 +19,37 @@ var statusCmd = &cobra.Command{
 	Long: `Track a live flight by its IATA flight number (e.g. AA100, KE38).
 
 ICAO flight numbers are also supported (e.g. UAL2189). The lookup tries the
-ICAO code first, then falls back to IATA if the airline is in the embedded dataset.`,
-	Args: cobra.ExactArgs(1),
+ICAO code first, then falls back to IATA if the airline is in the embedded dataset.
+Radio callsigns work too (e.g. SPEEDBIRD 12, AMERICAN 100): the airline's
+callsign is looked up in the airlines table and turned into its flight number.
+
+Some IATA codes are shared by more than one airline. The airline marked
+active in the embedded dataset or your override files is used; when several
+of them are active, status lists the ICAO flight number for each instead of
+guessing, so you can pick one.
+
+Use --date YYYY-MM-DD for a past day's flight. Historical lookups need an
+AviationStack plan that includes them; once a date is over, its results are
+cached for 30 days.`,
+	Args: cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		apiKey, err := requireAPIKey()
-		if err != nil {
-			printAPIKeyError()
-			cobra.CheckErr(err)
+		flightNumber, err := flightNumberFromArgs(args)
+		cobra.CheckErr(err)
+
+		if code, alternatives := sharedCodeAlternatives(flightNumber); len(alternatives) > 0 {
+			fmt.Fprintf(os.Stderr, "Error: %s is used by more than one airline, so %s could be any of their flights\n", code, strings.ToUpper(flightNumber))
+			printAlternatives("Try an ICAO flight number:", alternatives)
+			os.Exit(1)
 		}
 
-		flightNumber := args[0]
-		svc := newFlightService(apiKey, true)
+		p, err := newProvider()
+		cobra.CheckErr(err)
+
+		opts, err := optionsFromFlags(cmd)
+		cobra.CheckErr(err)
+
+		svc := newFlightService(p, true)
+		svc.Options = opts
 
 		s := display.NewSpinner(fmt.Sprintf("Fetching status for %s...", flightNumber))
 		s.Start()
@@ -34,21 +57,50 @@ ICAO code first, then falls back to IATA if the airline is in the embedded datas
 		s.Stop()
 
 		if err != nil {
-			cobra.CheckErr(fmt.Errorf("fetching status for flight %s: %w", flightNumber, err))
+			printProviderErr(fmt.Errorf("fetching status for flight %s: %w", flightNumber, err))
+			os.Exit(1)
 		}
 
 		if jsonOutput {
 			cobra.CheckErr(printJSONOutput(flight))
-			return
-		}
-
-		display.PrintFlightStatus(flight)
-		if cached {
-			display.PrintCachedIndicator()
+		} else {
+			display.PrintFlightStatus(flight)
+			display.PrintCachedIndicator(cached, flight.Source)
 		}
 	},
 }
 
+// sharedCodeAlternatives returns the airline code of an IATA flight number
+// and, when the code is ambiguous between several airlines, the ICAO flight
+// number and name for each of them, e.g. "AZU4101  Azul" for AD4101.
+func sharedCodeAlternatives(flightNumber string) (string, []string) {
+	input := strings.ToUpper(strings.TrimSpace(flightNumber))
+	if len(input) < 3 || input[2] < '0' || input[2] > '9' {
+		return "", nil
+	}
+	code, number := input[:2], strings.TrimLeft(input[2:], "0")
+	if !airlines.Ambiguous(code) {
+		return code, nil
+	}
+
+	var alternatives []string
+	for _, a := range airlines.AllByIATA(code) {
+		alternatives = append(alternatives, fmt.Sprintf("%-8s %s", a.ICAO+number, a.Name))
+	}
+	return code, alternatives
+}
+
+// printAlternatives writes a heading and indented list to stderr, keeping
+// stdout clean for --json.
+func printAlternatives(heading string, alternatives []string) {
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, heading)
+	for _, alternative := range alternatives {
+		fmt.Fprintln(os.Stderr, "  "+alternative)
+	}
+}
+
 func init() {
 	rootCmd.AddCommand(statusCmd)
+	addDateFlag(statusCmd)
 }
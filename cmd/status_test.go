@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/joshuachuah/flightcli/internal/airlines"
+)
+
+func TestSharedCodeAlternativesListsEachAirline(t *testing.T) {
+	for _, input := range []string{"UA100", "LH400", "AD4101", "UAL100", "AAL100"} {
+		if _, alternatives := sharedCodeAlternatives(input); alternatives != nil {
+			t.Errorf("expected no alternatives for %s, got %q", input, alternatives)
+		}
+	}
+
+	// Marking both airlines using AD as active makes the code ambiguous.
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "airlines.csv"), []byte("icao,active\nAZU,Y\nPRZ,Y\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := airlines.LoadOverrides(dir); err != nil {
+		t.Fatalf("LoadOverrides returned error: %v", err)
+	}
+	t.Cleanup(func() { airlines.LoadOverrides(t.TempDir()) })
+
+	code, alternatives := sharedCodeAlternatives("ad04101")
+	want := []string{"PRZ4101  Air Paradise International", "AZU4101  Azul"}
+	if code != "AD" || !slices.Equal(alternatives, want) {
+		t.Fatalf("expected AD alternatives %q, got %q %q", want, code, alternatives)
+	}
+}
+
+func TestFlightNumberFromArgsJoinsRadioCallsigns(t *testing.T) {
+	got, err := flightNumberFromArgs([]string{"SPEEDBIRD", "12"})
+	if err != nil || got != "SPEEDBIRD 12" {
+		t.Fatalf("expected the callsign to be joined, got %q, %v", got, err)
+	}
+	for _, args := range [][]string{{"TAM", "3054"}, {"AIR", "12"}} {
+		if _, err := flightNumberFromArgs(args); err != nil {
+			t.Errorf("expected %q to be accepted, got %v", args, err)
+		}
+	}
+	if _, err := flightNumberFromArgs([]string{"SPEEDBRID", "12"}); err == nil {
+		t.Fatal("expected an unknown callsign to be rejected")
+	}
+}
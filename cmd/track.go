@@ -4,6 +4,7 @@ Copyright 2026 Joshua Chuah <jchuah07@gmail.com>
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
@@ -12,15 +13,28 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/joshuachuah/flightcli/internal/display"
+	"github.com/joshuachuah/flightcli/internal/provider"
+	"github.com/joshuachuah/flightcli/internal/service"
 )
 
 var trackInterval int
 
+// liveRedrawInterval coalesces bursts of live-feed messages into at most
+// one redraw per interval.
+const liveRedrawInterval = time.Second
+
 var trackCmd = &cobra.Command{
 	Use:   "track [flightNumber]",
 	Short: "Live-track a flight, refreshing automatically",
-	Long:  `Continuously poll and display live flight status, refreshing on a fixed interval. Press Ctrl+C to stop.`,
-	Args:  cobra.ExactArgs(1),
+	Long: `Continuously poll and display live flight status, refreshing on a fixed interval. Press Ctrl+C to stop.
+
+The flight can be given as a flight number (BA12, BAW12) or as a radio
+callsign (SPEEDBIRD 12).
+
+With --provider sbs the display updates as messages arrive from an SBS-1
+(BaseStation) feed instead of waiting for the interval, also when sbs is
+one of several providers (--provider sbs,aviationstack).`,
+	Args:  cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		if jsonOutput {
 			cobra.CheckErr("--json is not supported with track (live mode); use 'flightcli status --json' for a snapshot")
@@ -29,17 +43,16 @@ var trackCmd = &cobra.Command{
 			cobra.CheckErr("--interval must be greater than 0 seconds")
 		}
 
-		apiKey, err := requireAPIKey()
-		if err != nil {
-			printAPIKeyError()
-			cobra.CheckErr(err)
-		}
+		flightNumber, err := flightNumberFromArgs(args)
+		cobra.CheckErr(err)
+
+		p, err := newProvider()
+		cobra.CheckErr(err)
 
-		flightNumber := args[0]
 		interval := time.Duration(trackInterval) * time.Second
-		svc := newFlightService(apiKey, false)
 		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
 		defer stop()
+		svc := newTrackService(ctx, p)
 
 		ticker := time.NewTicker(interval)
 		defer ticker.Stop()
@@ -52,6 +65,7 @@ var trackCmd = &cobra.Command{
 				return
 			}
 
+			updates := svc.Updates()
 			fmt.Print("\033[2J\033[H")
 
 			s := display.NewSpinner(fmt.Sprintf("Fetching status for %s...", flightNumber))
@@ -65,24 +79,44 @@ var trackCmd = &cobra.Command{
 					stopTracking()
 					return
 				}
-				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				printProviderErr(err)
 			} else {
 				display.PrintFlightStatus(flight)
 			}
 
 			fmt.Printf("\nLast updated: %s\n", time.Now().Format("15:04:05"))
-			display.DimPrint(fmt.Sprintf("Refreshing every %ds - Press Ctrl+C to stop", trackInterval))
+			if updates != nil {
+				display.DimPrint("Updating as messages arrive - Press Ctrl+C to stop")
+			} else {
+				display.DimPrint(fmt.Sprintf("Refreshing every %ds - Press Ctrl+C to stop", trackInterval))
+			}
 
 			select {
 			case <-ctx.Done():
 				stopTracking()
 				return
 			case <-ticker.C:
+			case <-updates:
+				select {
+				case <-ctx.Done():
+					stopTracking()
+					return
+				case <-time.After(liveRedrawInterval):
+				}
 			}
 		}
 	},
 }
 
+// newTrackService starts p's live feed, if it has one, until ctx is done.
+// The service's Updates then fire as messages arrive.
+func newTrackService(ctx context.Context, p provider.FlightProvider) service.FlightService {
+	if live, ok := p.(provider.LiveProvider); ok {
+		live.Start(ctx)
+	}
+	return newFlightService(p, false)
+}
+
 func init() {
 	rootCmd.AddCommand(trackCmd)
 	trackCmd.Flags().IntVar(&trackInterval, "interval", 30, "Refresh interval in seconds")
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestTrackFollowsSBSFeedInProviderChain(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	send := make(chan string)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for line := range send {
+			fmt.Fprint(conn, line)
+		}
+	}()
+	t.Cleanup(func() { close(send) })
+
+	originalName, originalAddr := providerName, sbsAddr
+	t.Cleanup(func() { providerName, sbsAddr = originalName, originalAddr })
+	providerName, sbsAddr = "sbs,opensky", listener.Addr().String()
+
+	p, err := newProvider()
+	if err != nil {
+		t.Fatalf("newProvider returned error: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	svc := newTrackService(ctx, p)
+
+	updates := svc.Updates()
+	if updates == nil {
+		t.Fatal("expected the chain to pass on the SBS feed's updates")
+	}
+	send <- "MSG,1,1,1,A1B2C3,1,,,,,BAW117,,,,,,,,,,,\r\n"
+	select {
+	case <-updates:
+	case <-time.After(3 * time.Second):
+		t.Fatal("expected a message on the feed to trigger a redraw")
+	}
+
+	flight, _, err := svc.GetStatus(ctx, "BA117")
+	if err != nil || flight.FlightNumber != "BA117" || flight.Source != "sbs" {
+		t.Fatalf("expected BA117 from the sbs feed, got %#v, %v", flight, err)
+	}
+}
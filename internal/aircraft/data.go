@@ -0,0 +1,75 @@
+// Package aircraft provides an embedded table of common aircraft types,
+// keyed by ICAO type designator (e.g. "B738") with the matching IATA code
+// (e.g. "738").
+//
+// Data source: ICAO Doc 8643 aircraft type designators and the IATA
+// aircraft codes in use by airlines.
+package aircraft
+
+import (
+	"sort"
+	"strings"
+)
+
+// Type holds metadata for a single aircraft type.
+type Type struct {
+	ICAO         string // ICAO type designator (e.g. "B738")
+	IATA         string // IATA aircraft code (e.g. "738")
+	Manufacturer string // Manufacturer (e.g. "Boeing")
+	Model        string // Model (e.g. "737-800")
+}
+
+// Name returns the manufacturer and model, e.g. "Boeing 737-800".
+func (t Type) Name() string {
+	return t.Manufacturer + " " + t.Model
+}
+
+// iataToType indexes icaoToType by IATA code. A few IATA codes cover more
+// than one ICAO designator; the alphabetically first designator wins so
+// lookups are stable.
+var iataToType = func() map[string]Type {
+	icaoCodes := make([]string, 0, len(icaoToType))
+	for icao := range icaoToType {
+		icaoCodes = append(icaoCodes, icao)
+	}
+	sort.Strings(icaoCodes)
+
+	byIATA := make(map[string]Type, len(icaoCodes))
+	for _, icao := range icaoCodes {
+		t := icaoToType[icao]
+		if _, ok := byIATA[t.IATA]; t.IATA != "" && !ok {
+			byIATA[t.IATA] = t
+		}
+	}
+	return byIATA
+}()
+
+// ByICAO returns the aircraft type for an ICAO type designator, or nil.
+func ByICAO(icao string) *Type {
+	if t, ok := icaoToType[strings.ToUpper(strings.TrimSpace(icao))]; ok {
+		return &t
+	}
+	return nil
+}
+
+// ByIATA returns the aircraft type for an IATA aircraft code, or nil.
+func ByIATA(iata string) *Type {
+	if t, ok := iataToType[strings.ToUpper(strings.TrimSpace(iata))]; ok {
+		return &t
+	}
+	return nil
+}
+
+// Lookup returns the aircraft type for an ICAO designator, falling back to
+// an IATA code, or nil when neither is known. Providers are inconsistent
+// about which kind of code they report, so callers can pass both.
+func Lookup(icao, iata string) *Type {
+	if t := ByICAO(icao); t != nil {
+		return t
+	}
+	if t := ByIATA(iata); t != nil {
+		return t
+	}
+	// Some feeds put the ICAO designator in the IATA field.
+	return ByICAO(iata)
+}
@@ -0,0 +1,43 @@
+package aircraft
+
+import "testing"
+
+func TestEmbeddedTableInvariants(t *testing.T) {
+	for icao, typ := range icaoToType {
+		if len(icao) < 2 || len(icao) > 4 {
+			t.Errorf("ICAO key %q has length %d, want 2-4", icao, len(icao))
+		}
+		if typ.ICAO != icao {
+			t.Errorf("ICAO key %q points to type ICAO %q", icao, typ.ICAO)
+		}
+		if typ.Manufacturer == "" || typ.Model == "" {
+			t.Errorf("type for ICAO %q has empty required fields: %#v", icao, typ)
+		}
+		if typ.IATA != "" && len(typ.IATA) != 3 {
+			t.Errorf("type for ICAO %q has IATA %q, want 3 characters", icao, typ.IATA)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		icao, iata string
+		want       string
+	}{
+		{"B738", "", "Boeing 737-800"},
+		{"b38m", "", "Boeing 737 MAX 8"},
+		{"", "738", "Boeing 737-800"},
+		{"", "A321", "Airbus A321"},
+		{"ZZZZ", "32N", "Airbus A320neo"},
+		{"", "E75", "Embraer E175"},
+	}
+	for _, tt := range tests {
+		got := Lookup(tt.icao, tt.iata)
+		if got == nil || got.Name() != tt.want {
+			t.Errorf("Lookup(%q, %q) = %v, want %s", tt.icao, tt.iata, got, tt.want)
+		}
+	}
+	if got := Lookup("ZZZZ", "ZZZ"); got != nil {
+		t.Errorf("expected unknown codes to return nil, got %#v", got)
+	}
+}
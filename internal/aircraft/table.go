@@ -0,0 +1,88 @@
+// Aircraft types keyed by ICAO type designator.
+// Add rows in ICAO order; IATA codes may repeat across related variants.
+package aircraft
+
+var icaoToType = map[string]Type{
+	"A19N": {ICAO: "A19N", IATA: "31N", Manufacturer: "Airbus", Model: "A319neo"},
+	"A20N": {ICAO: "A20N", IATA: "32N", Manufacturer: "Airbus", Model: "A320neo"},
+	"A21N": {ICAO: "A21N", IATA: "32Q", Manufacturer: "Airbus", Model: "A321neo"},
+	"A306": {ICAO: "A306", IATA: "AB6", Manufacturer: "Airbus", Model: "A300-600"},
+	"A310": {ICAO: "A310", IATA: "310", Manufacturer: "Airbus", Model: "A310"},
+	"A318": {ICAO: "A318", IATA: "318", Manufacturer: "Airbus", Model: "A318"},
+	"A319": {ICAO: "A319", IATA: "319", Manufacturer: "Airbus", Model: "A319"},
+	"A320": {ICAO: "A320", IATA: "320", Manufacturer: "Airbus", Model: "A320"},
+	"A321": {ICAO: "A321", IATA: "321", Manufacturer: "Airbus", Model: "A321"},
+	"A332": {ICAO: "A332", IATA: "332", Manufacturer: "Airbus", Model: "A330-200"},
+	"A333": {ICAO: "A333", IATA: "333", Manufacturer: "Airbus", Model: "A330-300"},
+	"A338": {ICAO: "A338", IATA: "338", Manufacturer: "Airbus", Model: "A330-800"},
+	"A339": {ICAO: "A339", IATA: "339", Manufacturer: "Airbus", Model: "A330-900"},
+	"A343": {ICAO: "A343", IATA: "343", Manufacturer: "Airbus", Model: "A340-300"},
+	"A346": {ICAO: "A346", IATA: "346", Manufacturer: "Airbus", Model: "A340-600"},
+	"A359": {ICAO: "A359", IATA: "359", Manufacturer: "Airbus", Model: "A350-900"},
+	"A35K": {ICAO: "A35K", IATA: "351", Manufacturer: "Airbus", Model: "A350-1000"},
+	"A388": {ICAO: "A388", IATA: "388", Manufacturer: "Airbus", Model: "A380-800"},
+	"AT43": {ICAO: "AT43", IATA: "AT4", Manufacturer: "ATR", Model: "42-300"},
+	"AT45": {ICAO: "AT45", IATA: "AT5", Manufacturer: "ATR", Model: "42-500"},
+	"AT72": {ICAO: "AT72", IATA: "AT7", Manufacturer: "ATR", Model: "72-200"},
+	"AT75": {ICAO: "AT75", IATA: "AT7", Manufacturer: "ATR", Model: "72-500"},
+	"AT76": {ICAO: "AT76", IATA: "AT7", Manufacturer: "ATR", Model: "72-600"},
+	"B37M": {ICAO: "B37M", IATA: "7M7", Manufacturer: "Boeing", Model: "737 MAX 7"},
+	"B38M": {ICAO: "B38M", IATA: "7M8", Manufacturer: "Boeing", Model: "737 MAX 8"},
+	"B39M": {ICAO: "B39M", IATA: "7M9", Manufacturer: "Boeing", Model: "737 MAX 9"},
+	"B3XM": {ICAO: "B3XM", IATA: "7MJ", Manufacturer: "Boeing", Model: "737 MAX 10"},
+	"B712": {ICAO: "B712", IATA: "717", Manufacturer: "Boeing", Model: "717-200"},
+	"B733": {ICAO: "B733", IATA: "733", Manufacturer: "Boeing", Model: "737-300"},
+	"B734": {ICAO: "B734", IATA: "734", Manufacturer: "Boeing", Model: "737-400"},
+	"B735": {ICAO: "B735", IATA: "735", Manufacturer: "Boeing", Model: "737-500"},
+	"B736": {ICAO: "B736", IATA: "736", Manufacturer: "Boeing", Model: "737-600"},
+	"B737": {ICAO: "B737", IATA: "73G", Manufacturer: "Boeing", Model: "737-700"},
+	"B738": {ICAO: "B738", IATA: "738", Manufacturer: "Boeing", Model: "737-800"},
+	"B739": {ICAO: "B739", IATA: "739", Manufacturer: "Boeing", Model: "737-900"},
+	"B744": {ICAO: "B744", IATA: "744", Manufacturer: "Boeing", Model: "747-400"},
+	"B748": {ICAO: "B748", IATA: "74H", Manufacturer: "Boeing", Model: "747-8"},
+	"B752": {ICAO: "B752", IATA: "752", Manufacturer: "Boeing", Model: "757-200"},
+	"B753": {ICAO: "B753", IATA: "753", Manufacturer: "Boeing", Model: "757-300"},
+	"B762": {ICAO: "B762", IATA: "762", Manufacturer: "Boeing", Model: "767-200"},
+	"B763": {ICAO: "B763", IATA: "763", Manufacturer: "Boeing", Model: "767-300"},
+	"B764": {ICAO: "B764", IATA: "764", Manufacturer: "Boeing", Model: "767-400"},
+	"B772": {ICAO: "B772", IATA: "772", Manufacturer: "Boeing", Model: "777-200"},
+	"B773": {ICAO: "B773", IATA: "773", Manufacturer: "Boeing", Model: "777-300"},
+	"B778": {ICAO: "B778", IATA: "778", Manufacturer: "Boeing", Model: "777-8"},
+	"B779": {ICAO: "B779", IATA: "779", Manufacturer: "Boeing", Model: "777-9"},
+	"B77L": {ICAO: "B77L", IATA: "77L", Manufacturer: "Boeing", Model: "777-200LR"},
+	"B77W": {ICAO: "B77W", IATA: "77W", Manufacturer: "Boeing", Model: "777-300ER"},
+	"B788": {ICAO: "B788", IATA: "788", Manufacturer: "Boeing", Model: "787-8"},
+	"B789": {ICAO: "B789", IATA: "789", Manufacturer: "Boeing", Model: "787-9"},
+	"B78X": {ICAO: "B78X", IATA: "781", Manufacturer: "Boeing", Model: "787-10"},
+	"BCS1": {ICAO: "BCS1", IATA: "221", Manufacturer: "Airbus", Model: "A220-100"},
+	"BCS3": {ICAO: "BCS3", IATA: "223", Manufacturer: "Airbus", Model: "A220-300"},
+	"C208": {ICAO: "C208", IATA: "CN1", Manufacturer: "Cessna", Model: "208 Caravan"},
+	"C919": {ICAO: "C919", IATA: "919", Manufacturer: "COMAC", Model: "C919"},
+	"CRJ2": {ICAO: "CRJ2", IATA: "CR2", Manufacturer: "Bombardier", Model: "CRJ200"},
+	"CRJ7": {ICAO: "CRJ7", IATA: "CR7", Manufacturer: "Bombardier", Model: "CRJ700"},
+	"CRJ9": {ICAO: "CRJ9", IATA: "CR9", Manufacturer: "Bombardier", Model: "CRJ900"},
+	"CRJX": {ICAO: "CRJX", IATA: "CRK", Manufacturer: "Bombardier", Model: "CRJ1000"},
+	"DC10": {ICAO: "DC10", IATA: "D10", Manufacturer: "McDonnell Douglas", Model: "DC-10"},
+	"DH8A": {ICAO: "DH8A", IATA: "DH1", Manufacturer: "De Havilland Canada", Model: "Dash 8-100"},
+	"DH8C": {ICAO: "DH8C", IATA: "DH3", Manufacturer: "De Havilland Canada", Model: "Dash 8-300"},
+	"DH8D": {ICAO: "DH8D", IATA: "DH4", Manufacturer: "De Havilland Canada", Model: "Dash 8-400"},
+	"E135": {ICAO: "E135", IATA: "ER3", Manufacturer: "Embraer", Model: "ERJ-135"},
+	"E145": {ICAO: "E145", IATA: "ER4", Manufacturer: "Embraer", Model: "ERJ-145"},
+	"E170": {ICAO: "E170", IATA: "E70", Manufacturer: "Embraer", Model: "E170"},
+	"E190": {ICAO: "E190", IATA: "E90", Manufacturer: "Embraer", Model: "E190"},
+	"E195": {ICAO: "E195", IATA: "E95", Manufacturer: "Embraer", Model: "E195"},
+	"E290": {ICAO: "E290", IATA: "290", Manufacturer: "Embraer", Model: "E190-E2"},
+	"E295": {ICAO: "E295", IATA: "295", Manufacturer: "Embraer", Model: "E195-E2"},
+	"E75L": {ICAO: "E75L", IATA: "E75", Manufacturer: "Embraer", Model: "E175"},
+	"E75S": {ICAO: "E75S", IATA: "E75", Manufacturer: "Embraer", Model: "E175"},
+	"F100": {ICAO: "F100", IATA: "100", Manufacturer: "Fokker", Model: "100"},
+	"F70":  {ICAO: "F70", IATA: "F70", Manufacturer: "Fokker", Model: "70"},
+	"MD11": {ICAO: "MD11", IATA: "M11", Manufacturer: "McDonnell Douglas", Model: "MD-11"},
+	"MD82": {ICAO: "MD82", IATA: "M82", Manufacturer: "McDonnell Douglas", Model: "MD-82"},
+	"MD83": {ICAO: "MD83", IATA: "M83", Manufacturer: "McDonnell Douglas", Model: "MD-83"},
+	"MD88": {ICAO: "MD88", IATA: "M88", Manufacturer: "McDonnell Douglas", Model: "MD-88"},
+	"MD90": {ICAO: "MD90", IATA: "M90", Manufacturer: "McDonnell Douglas", Model: "MD-90"},
+	"PC12": {ICAO: "PC12", IATA: "PL2", Manufacturer: "Pilatus", Model: "PC-12"},
+	"SF34": {ICAO: "SF34", IATA: "SF3", Manufacturer: "Saab", Model: "340"},
+	"SU95": {ICAO: "SU95", IATA: "SU9", Manufacturer: "Sukhoi", Model: "Superjet 100"},
+}
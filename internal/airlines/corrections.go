@@ -0,0 +1,13 @@
+package airlines
+
+// overrides amends OpenFlights entries where the current operational
+// IATA code differs from what OpenFlights records or where CSV parsing
+// corrupted metadata. It is kept apart from the generated table.go so
+// regenerating that file leaves the corrections in place.
+var overrides = map[string]Airline{
+	"ASA": {Name: "Alaska Airlines", IATA: "AS", ICAO: "ASA", Callsign: "ALASKA", Country: "United States"},
+	"AVA": {Name: "Avianca", IATA: "AV", ICAO: "AVA", Callsign: "AVIANCA", Country: "Colombia"},
+	"EQL": {Name: "Air S", IATA: "KY", ICAO: "EQL", Callsign: "EQUATORIAL", Country: "Equatorial Guinea"},
+	"HDA": {Name: "Dragonair", IATA: "KA", ICAO: "HDA", Callsign: "DRAGON", Country: "Hong Kong"},
+	"RPA": {Name: "Republic Airways", IATA: "YX", ICAO: "RPA", Callsign: "BRICKYARD", Country: "United States"},
+}
@@ -7,6 +7,8 @@
 // Generated: 2026-05-02
 package airlines
 
+//go:generate go run gen.go
+
 import "strings"
 
 // Airline holds metadata for a single airline.
@@ -21,6 +23,9 @@ type Airline struct {
 // ByICAO returns airline metadata for a 3-letter ICAO code, or nil.
 func ByICAO(icao string) *Airline {
 	icao = strings.ToUpper(strings.TrimSpace(icao))
+	if a, ok := local[icao]; ok {
+		return &a
+	}
 	if a, ok := overrides[icao]; ok {
 		return &a
 	}
@@ -31,13 +36,11 @@ func ByICAO(icao string) *Airline {
 }
 
 // ByIATA returns airline metadata for a 2-character IATA code, or nil.
+// When several airlines share the code, the preferred one from AllByIATA
+// is returned; use Ambiguous to find out whether that is a guess.
 func ByIATA(iata string) *Airline {
-	iata = strings.ToUpper(strings.TrimSpace(iata))
-	if a, ok := iataToAirline[iata]; ok {
-		if override, ok := overrides[a.ICAO]; ok {
-			return &override
-		}
-		return &a
+	if all := AllByIATA(iata); len(all) > 0 {
+		return &all[0]
 	}
 	return nil
 }
@@ -51,7 +54,8 @@ func IATACode(icao string) string {
 }
 
 // ICAOCode returns the ICAO code for a given IATA code, or "" if not found.
-// If multiple airlines share the same IATA code, the first is returned.
+// If multiple airlines share the same IATA code, the preferred one is
+// returned, as with ByIATA.
 func ICAOCode(iata string) string {
 	if a := ByIATA(iata); a != nil {
 		return a.ICAO
@@ -62,10 +66,5 @@ func ICAOCode(iata string) string {
 // IsICAOCode returns true if the 3-letter prefix looks like a valid ICAO
 // airline designator present in our dataset.
 func IsICAOCode(prefix string) bool {
-	prefix = strings.ToUpper(strings.TrimSpace(prefix))
-	if _, ok := overrides[prefix]; ok {
-		return true
-	}
-	_, ok := icaoToAirline[prefix]
-	return ok
+	return ByICAO(prefix) != nil
 }
@@ -1,6 +1,9 @@
 package airlines
 
-import "testing"
+import (
+	"slices"
+	"testing"
+)
 
 func TestEmbeddedDatasetInvariants(t *testing.T) {
 	for icao, airline := range icaoToAirline {
@@ -28,6 +31,17 @@ func TestEmbeddedDatasetInvariants(t *testing.T) {
 		}
 	}
 
+	for iata, list := range iataIndex {
+		for _, airline := range list {
+			if airline.IATA != iata {
+				t.Errorf("IATA key %q points to airline IATA %q", iata, airline.IATA)
+			}
+			if ByICAO(airline.ICAO) == nil {
+				t.Errorf("IATA key %q points to missing ICAO %q", iata, airline.ICAO)
+			}
+		}
+	}
+
 	for iata, airline := range iataToAirline {
 		if airline.IATA != iata {
 			t.Errorf("IATA key %q points to airline IATA %q", iata, airline.IATA)
@@ -126,3 +140,144 @@ func TestEmbeddedDatasetOverridesCorruptedRows(t *testing.T) {
 		t.Fatalf("expected ENK country to be Russia (no stray brackets), got %q", enkor.Country)
 	}
 }
+
+func TestAllByIATAListsEveryAirlineSharingACode(t *testing.T) {
+	if got := icaoCodes(AllByIATA("g8")); !slices.Equal(got, []string{"AGB", "ENK", "GOW", "GUJ"}) {
+		t.Fatalf("expected G8 to list every airline by ICAO code, got %v", got)
+	}
+	if got := icaoCodes(AllByIATA("LH")); !slices.Equal(got, []string{"DLH", "GEC"}) {
+		t.Fatalf("expected Lufthansa first for LH, got %v", got)
+	}
+	if got := AllByIATA("GI"); len(got) != 1 || got[0].Name != "Itek Air" {
+		t.Fatalf("expected GI to list Itek Air, got %#v", got)
+	}
+
+	alaska := AllByIATA("AS")
+	if len(alaska) != 1 || alaska[0].Callsign != "ALASKA" {
+		t.Fatalf("expected AS to list the corrected Alaska entry, got %#v", alaska)
+	}
+	if got := AllByIATA("4C"); len(got) != 0 {
+		t.Fatalf("expected no airlines for removed code 4C, got %#v", got)
+	}
+}
+
+func TestSearchMatchesNamesCallsignsAndTypos(t *testing.T) {
+	tests := []struct {
+		query string
+		first string
+	}{
+		{query: "United Airlines", first: "UAL"},
+		{query: "speedbird", first: "BAW"},
+		{query: "lufthnasa", first: "DLH"},
+		{query: "DAL", first: "DAL"},
+		{query: "ua", first: "UAL"},
+	}
+
+	for _, tt := range tests {
+		results := Search(tt.query)
+		if len(results) == 0 || results[0].ICAO != tt.first {
+			var got []string
+			for _, a := range results {
+				got = append(got, a.ICAO)
+			}
+			t.Errorf("Search(%q) = %v, want %s first", tt.query, got, tt.first)
+		}
+	}
+
+	if got := Search("ua"); len(got) != 1 {
+		t.Errorf("expected a two-letter query to match codes and word starts only, got %d results", len(got))
+	}
+	if got := Search(" - "); got != nil {
+		t.Errorf("expected no results for a query without letters, got %d", len(got))
+	}
+}
+
+func TestEditDistanceCountsSwapsAsOneEdit(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"united", "united", 0},
+		{"untied", "united", 1},
+		{"delta", "detla", 1},
+		{"delta", "dleta", 1},
+		{"qantas", "qatar", 2},
+		{"", "abc", 3},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestEveryGeneratedIATACodeStillResolves(t *testing.T) {
+	for iata, want := range iataToAirline {
+		if override, ok := overrides[want.ICAO]; ok && override.IATA == iata {
+			want = override
+		}
+		got := ByIATA(iata)
+		if got == nil || got.ICAO != want.ICAO || got.Name != want.Name {
+			t.Errorf("ByIATA(%q) = %#v, want %s (%s)", iata, got, want.ICAO, want.Name)
+		}
+	}
+}
+
+func TestActiveFlagDecidesSharedCodes(t *testing.T) {
+	original := active
+	t.Cleanup(func() {
+		active = original
+		iataIndex = buildIATAIndex()
+	})
+	setActive := func(flags map[string]bool) {
+		active = flags
+		iataIndex = buildIATAIndex()
+	}
+
+	if Ambiguous("LH") {
+		t.Fatal("expected LH to fall back on the generated pick without active flags")
+	}
+
+	setActive(map[string]bool{"DLH": false, "GEC": true})
+	if got := ICAOCode("LH"); got != "GEC" || Ambiguous("LH") {
+		t.Fatalf("expected the only active airline GEC for LH, got %q (ambiguous %t)", got, Ambiguous("LH"))
+	}
+
+	setActive(map[string]bool{"DLH": true, "GEC": true})
+	if !Ambiguous("LH") {
+		t.Fatal("expected LH to be ambiguous with two active airlines")
+	}
+	if got := icaoCodes(AllByIATA("LH")); !slices.Equal(got, []string{"DLH", "GEC"}) {
+		t.Fatalf("expected the pick DLH first among tied airlines, got %v", got)
+	}
+
+	setActive(map[string]bool{"RPA": false, "MEP": false})
+	if got := ICAOCode("YX"); got != "RPA" || Ambiguous("YX") {
+		t.Fatalf("expected the built-in correction RPA to count as active for YX, got %q", got)
+	}
+}
+
+func TestByCallsignIgnoresCaseAndSpacing(t *testing.T) {
+	tests := map[string]string{
+		"SPEEDBIRD":  "BAW",
+		"speedbird":  "BAW",
+		"American":   "AAL",
+		"SPEED BIRD": "BAW",
+	}
+	for callsign, want := range tests {
+		if got := ByCallsign(callsign); got == nil || got.ICAO != want {
+			t.Errorf("ByCallsign(%q) = %#v, want %s", callsign, got, want)
+		}
+	}
+	if got := ByCallsign("SPEEDBRID"); got != nil {
+		t.Errorf("expected no airline for a misspelled callsign, got %#v", got)
+	}
+}
+
+func icaoCodes(list []Airline) []string {
+	var codes []string
+	for _, a := range list {
+		codes = append(codes, a.ICAO)
+	}
+	return codes
+}
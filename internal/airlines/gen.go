@@ -0,0 +1,204 @@
+//go:build ignore
+
+// gen.go regenerates table.go from the OpenFlights airlines.dat file,
+// keeping every airline with both an IATA and an ICAO code along with its
+// active flag:
+//
+//	go generate ./internal/airlines
+//	go run gen.go -in airlines.dat   # from a local copy
+//
+// Built-in corrections live in corrections.go and are left alone.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const sourceURL = "https://raw.githubusercontent.com/jpatokal/openflights/master/data/airlines.dat"
+
+// Columns of airlines.dat.
+const (
+	colName = 1 + iota
+	colAlias
+	colIATA
+	colICAO
+	colCallsign
+	colCountry
+	colActive
+	numColumns
+)
+
+type airline struct {
+	Name, IATA, ICAO, Callsign, Country string
+	Active                              bool
+}
+
+func main() {
+	in := flag.String("in", "", "read airlines.dat from this file instead of "+sourceURL)
+	out := flag.String("out", "table.go", "file to write")
+	flag.Parse()
+
+	data, err := readSource(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	table, err := parse(data)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := render(table)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("wrote %d airlines to %s", len(table), *out)
+}
+
+func readSource(path string) ([]byte, error) {
+	if path != "" {
+		return os.ReadFile(path)
+	}
+	resp, err := http.Get(sourceURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", sourceURL, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// parse keeps one airline per ICAO code, the first active one listed or
+// else the first listed, and skips rows the package could not use: missing
+// codes, names or countries, or columns shifted by stray commas, which
+// leave the active flag something other than Y or N.
+func parse(data []byte) ([]airline, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+
+	byICAO := map[string]airline{}
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(row) != numColumns {
+			continue
+		}
+		activeFlag := field(row[colActive])
+		if activeFlag != "Y" && activeFlag != "N" {
+			continue
+		}
+		a := airline{
+			Name:     field(row[colName]),
+			IATA:     field(row[colIATA]),
+			ICAO:     field(row[colICAO]),
+			Callsign: field(row[colCallsign]),
+			Country:  field(row[colCountry]),
+			Active:   activeFlag == "Y",
+		}
+		if !isCode(a.IATA, 2) || !isCode(a.ICAO, 3) || a.Name == "" || a.Country == "" {
+			continue
+		}
+		if seen, ok := byICAO[a.ICAO]; ok && (seen.Active || !a.Active) {
+			continue
+		}
+		byICAO[a.ICAO] = a
+	}
+
+	table := make([]airline, 0, len(byICAO))
+	for _, a := range byICAO {
+		table = append(table, a)
+	}
+	sort.Slice(table, func(i, j int) bool { return table[i].ICAO < table[j].ICAO })
+	return table, nil
+}
+
+// field trims a value and maps the \N null marker to "".
+func field(s string) string {
+	s = strings.TrimSpace(s)
+	if s == `\N` {
+		return ""
+	}
+	return s
+}
+
+// isCode reports whether s is an n-character code: letters only for ICAO
+// codes, letters and digits for IATA codes.
+func isCode(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for _, c := range s {
+		if (c < 'A' || c > 'Z') && (n == 3 || c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// picks chooses the airline flying under each IATA code: the active one
+// with the lowest ICAO code, or the lowest ICAO code when none is active.
+func picks(table []airline) map[string]airline {
+	pick := map[string]airline{}
+	for _, a := range table {
+		if seen, ok := pick[a.IATA]; !ok || (a.Active && !seen.Active) {
+			pick[a.IATA] = a
+		}
+	}
+	return pick
+}
+
+func render(table []airline) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `// Airlines dataset derived from OpenFlights (ODbL license).
+// https://github.com/jpatokal/openflights/blob/master/data/airlines.dat
+// Code generated by gen.go on %s; DO NOT EDIT.
+package airlines
+
+`, time.Now().Format(time.DateOnly))
+
+	b.WriteString("// active records the OpenFlights active flag (Y or N) by ICAO code.\nvar active = map[string]bool{\n")
+	for _, a := range table {
+		fmt.Fprintf(&b, "\t%q: %t,\n", a.ICAO, a.Active)
+	}
+	b.WriteString("}\n\nvar icaoToAirline = map[string]Airline{\n")
+	for _, a := range table {
+		fmt.Fprintf(&b, "\t%q: %s,\n", a.ICAO, literal(a))
+	}
+	b.WriteString("}\n\nvar iataToAirline = map[string]Airline{\n")
+	pick := picks(table)
+	codes := make([]string, 0, len(pick))
+	for iata := range pick {
+		codes = append(codes, iata)
+	}
+	sort.Strings(codes)
+	for _, iata := range codes {
+		fmt.Fprintf(&b, "\t%q: %s,\n", iata, literal(pick[iata]))
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
+
+func literal(a airline) string {
+	return fmt.Sprintf("{Name: %q, IATA: %q, ICAO: %q, Callsign: %q, Country: %q}",
+		a.Name, a.IATA, a.ICAO, a.Callsign, a.Country)
+}
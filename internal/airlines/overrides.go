@@ -0,0 +1,349 @@
+package airlines
+
+import (
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Sources reported by Source for entries that do not come from a file.
+const (
+	SourceEmbedded = "embedded OpenFlights data"
+	SourceBuiltIn  = "built-in correction"
+)
+
+// OverrideFiles are the file names LoadOverrides looks for, in the order
+// they are merged, so a later file wins over an earlier one:
+//
+//   - airlines.dat: rows in the OpenFlights airlines.dat layout (ID, name,
+//     alias, IATA, ICAO, callsign, country, active), without a header.
+//   - airlines.csv: a header row naming any of the columns name, iata,
+//     icao, callsign, country and active, then one airline per row.
+//   - airlines.json: an array of objects with the same keys.
+//
+// Entries are keyed by ICAO code. Fields left empty keep the value of the
+// entry being replaced, so fixing a renamed airline only needs its ICAO
+// code and new name.
+var OverrideFiles = []string{"airlines.dat", "airlines.csv", "airlines.json"}
+
+// File describes an override file merged by LoadOverrides.
+type File struct {
+	Path     string
+	Entries  int      // airlines merged from the file
+	Problems []string // entries that were skipped, and why
+}
+
+var (
+	icaoPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	iataPattern = regexp.MustCompile(`^[A-Z0-9]{2}$`)
+)
+
+// Override file state, replaced as a whole by LoadOverrides.
+var (
+	local       = map[string]Airline{}
+	localSource = map[string]string{}
+	localActive = map[string]bool{}
+	loadedFiles []File
+)
+
+// override is one entry read from an override file, before it is merged.
+type override struct {
+	Airline
+	active *bool
+	where  string // position in the file, e.g. "line 3"
+}
+
+// DefaultOverrideDir returns ~/.flightcli, where LoadOverrides looks for
+// override files by default.
+func DefaultOverrideDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("could not determine home directory: %w", err)
+	}
+	return filepath.Join(home, ".flightcli"), nil
+}
+
+// LoadOverrides merges the OverrideFiles found in dir over the embedded
+// table, replacing whatever an earlier call loaded. Missing files are
+// skipped and invalid entries are left out and listed in File.Problems.
+// It returns an error only for a file that cannot be read or parsed at
+// all; the other files are still merged.
+//
+// Lookups are not synchronized with loading, so call it once at startup
+// before anything else uses the package.
+func LoadOverrides(dir string) ([]File, error) {
+	local = map[string]Airline{}
+	localSource = map[string]string{}
+	localActive = map[string]bool{}
+	loadedFiles = nil
+
+	var errs []error
+	for _, name := range OverrideFiles {
+		path := filepath.Join(dir, name)
+		f, err := os.Open(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("reading airline overrides: %w", err))
+			continue
+		}
+		entries, problems, err := parseOverrideFile(name, f)
+		f.Close()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("reading airline overrides from %s: %w", path, err))
+			continue
+		}
+
+		file := File{Path: path, Problems: problems}
+		for _, entry := range entries {
+			if problem := merge(entry, path); problem != "" {
+				file.Problems = append(file.Problems, entry.where+": "+problem)
+				continue
+			}
+			file.Entries++
+		}
+		loadedFiles = append(loadedFiles, file)
+	}
+
+	iataIndex = buildIATAIndex()
+	callsignIndex = buildCallsignIndex()
+	return LoadedFiles(), errors.Join(errs...)
+}
+
+// LoadedFiles returns the override files merged by the last LoadOverrides.
+func LoadedFiles() []File {
+	files := make([]File, len(loadedFiles))
+	copy(files, loadedFiles)
+	return files
+}
+
+// Overridden returns the airlines merged from override files, by ICAO code.
+func Overridden() []Airline {
+	list := make([]Airline, 0, len(local))
+	for _, a := range local {
+		list = append(list, a)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ICAO < list[j].ICAO })
+	return list
+}
+
+// Counts returns how many airlines the embedded table holds and how many of
+// them built-in corrections replace or add.
+func Counts() (embedded, builtIn int) {
+	return len(icaoToAirline), len(overrides)
+}
+
+// Source returns where the entry for an ICAO code came from: the path of
+// an override file, SourceBuiltIn or SourceEmbedded. It returns "" for
+// codes that are not in the table.
+func Source(icao string) string {
+	icao = strings.ToUpper(strings.TrimSpace(icao))
+	if path, ok := localSource[icao]; ok {
+		return path
+	}
+	if _, ok := overrides[icao]; ok {
+		return SourceBuiltIn
+	}
+	if _, ok := icaoToAirline[icao]; ok {
+		return SourceEmbedded
+	}
+	return ""
+}
+
+// merge validates an override entry, fills its empty fields from the entry
+// it replaces and stores it. It returns why the entry was rejected, or "".
+func merge(entry override, path string) string {
+	a := entry.Airline
+	a.ICAO = strings.ToUpper(strings.TrimSpace(a.ICAO))
+	a.IATA = strings.ToUpper(strings.TrimSpace(a.IATA))
+	if !icaoPattern.MatchString(a.ICAO) {
+		return fmt.Sprintf("invalid ICAO code %q: use 3 letters", a.ICAO)
+	}
+	if a.IATA != "" && !iataPattern.MatchString(a.IATA) {
+		return fmt.Sprintf("invalid IATA code %q for %s: use 2 letters or digits", a.IATA, a.ICAO)
+	}
+
+	if existing := ByICAO(a.ICAO); existing != nil {
+		a.Name = cmp.Or(strings.TrimSpace(a.Name), existing.Name)
+		a.IATA = cmp.Or(a.IATA, existing.IATA)
+		a.Callsign = cmp.Or(strings.TrimSpace(a.Callsign), existing.Callsign)
+		a.Country = cmp.Or(strings.TrimSpace(a.Country), existing.Country)
+	}
+	if a.Name == "" {
+		return fmt.Sprintf("%s is not in the table, so it needs a name", a.ICAO)
+	}
+
+	local[a.ICAO] = a
+	localSource[a.ICAO] = path
+	if entry.active != nil {
+		localActive[a.ICAO] = *entry.active
+	}
+	return ""
+}
+
+func parseOverrideFile(name string, r io.Reader) ([]override, []string, error) {
+	switch filepath.Ext(name) {
+	case ".dat":
+		return parseOpenFlights(r)
+	case ".csv":
+		return parseCSV(r)
+	default:
+		return parseJSON(r)
+	}
+}
+
+// parseOpenFlights reads rows in the OpenFlights airlines.dat layout, where
+// \N marks an empty field. Rows without an ICAO code are skipped quietly:
+// most of the upstream file has none, and the table is keyed by it.
+func parseOpenFlights(r io.Reader) ([]override, []string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	var entries []override
+	var problems []string
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return entries, problems, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(record) < 8 {
+			problems = append(problems, fmt.Sprintf("line %d: expected 8 fields, got %d", line, len(record)))
+			continue
+		}
+		field := func(i int) string {
+			if v := strings.TrimSpace(record[i]); v != `\N` && v != "-" {
+				return v
+			}
+			return ""
+		}
+		if field(4) == "" {
+			continue
+		}
+		entry := override{Airline: Airline{
+			Name:     field(1),
+			IATA:     field(3),
+			ICAO:     field(4),
+			Callsign: field(5),
+			Country:  field(6),
+		}}
+		active, err := parseActive(field(7))
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("line %d: %v", line, err))
+			continue
+		}
+		entry.active = active
+		entry.where = fmt.Sprintf("line %d", line)
+		entries = append(entries, entry)
+	}
+}
+
+// parseCSV reads a CSV file whose header names its columns.
+func parseCSV(r io.Reader) ([]override, []string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["icao"]; !ok {
+		return nil, nil, fmt.Errorf("the header row needs an icao column")
+	}
+
+	var entries []override
+	var problems []string
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return entries, problems, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		active, err := parseActive(field("active"))
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("line %d: %v", line, err))
+			continue
+		}
+		entries = append(entries, override{
+			Airline: Airline{
+				Name:     field("name"),
+				IATA:     field("iata"),
+				ICAO:     field("icao"),
+				Callsign: field("callsign"),
+				Country:  field("country"),
+			},
+			active: active,
+			where:  fmt.Sprintf("line %d", line),
+		})
+	}
+}
+
+// parseJSON reads an array of airline objects.
+func parseJSON(r io.Reader) ([]override, []string, error) {
+	var rows []struct {
+		Name     string `json:"name"`
+		IATA     string `json:"iata"`
+		ICAO     string `json:"icao"`
+		Callsign string `json:"callsign"`
+		Country  string `json:"country"`
+		Active   *bool  `json:"active"`
+	}
+	if err := json.NewDecoder(r).Decode(&rows); err != nil {
+		return nil, nil, err
+	}
+
+	entries := make([]override, len(rows))
+	for i, row := range rows {
+		entries[i] = override{
+			Airline: Airline{Name: row.Name, IATA: row.IATA, ICAO: row.ICAO, Callsign: row.Callsign, Country: row.Country},
+			active:  row.Active,
+			where:   fmt.Sprintf("entry %d", i+1),
+		}
+	}
+	return entries, nil, nil
+}
+
+// parseActive reads an active flag such as OpenFlights' "Y" and "N". An
+// empty value leaves the flag unknown.
+func parseActive(value string) (*bool, error) {
+	var active bool
+	switch strings.ToLower(value) {
+	case "":
+		return nil, nil
+	case "y", "yes", "true", "1":
+		active = true
+	case "n", "no", "false", "0":
+		active = false
+	default:
+		return nil, fmt.Errorf("invalid active flag %q: use Y or N", value)
+	}
+	return &active, nil
+}
@@ -0,0 +1,158 @@
+package airlines
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeOverrideFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// resetOverrides restores the embedded table once the test ends.
+func resetOverrides(t *testing.T) {
+	t.Cleanup(func() {
+		if _, err := LoadOverrides(t.TempDir()); err != nil {
+			t.Errorf("resetting overrides: %v", err)
+		}
+	})
+}
+
+func TestLoadOverridesMergesEveryFormat(t *testing.T) {
+	resetOverrides(t)
+	dir := t.TempDir()
+	dat := writeOverrideFile(t, dir, "airlines.dat", `1,"Test Air",\N,"T7","TZT","TESTAIR","Testland","Y"
+2,"No Code Air",\N,"-","N/A","","Nowhere","Y"
+3,"Old Air",\N,"",\N,\N,"Nowhere","N"
+`)
+	csvPath := writeOverrideFile(t, dir, "airlines.csv", "icao,name,country\nUAL,United,\n")
+	jsonPath := writeOverrideFile(t, dir, "airlines.json", `[{"icao": "tzt", "callsign": "TESTER"}]`)
+
+	files, err := LoadOverrides(dir)
+	if err != nil {
+		t.Fatalf("LoadOverrides returned error: %v", err)
+	}
+	if len(files) != 3 || files[0].Path != dat || files[1].Path != csvPath || files[2].Path != jsonPath {
+		t.Fatalf("expected the three files in merge order, got %#v", files)
+	}
+	if files[0].Entries != 1 || len(files[0].Problems) != 1 || !strings.HasPrefix(files[0].Problems[0], "line 2: ") {
+		t.Fatalf("expected one entry and a problem on line 2 of airlines.dat, got %#v", files[0])
+	}
+
+	test := ByICAO("TZT")
+	if test == nil || test.Name != "Test Air" || test.IATA != "T7" || test.Callsign != "TESTER" || test.Country != "Testland" {
+		t.Fatalf("expected airlines.json to update the airlines.dat entry, got %#v", test)
+	}
+	if got := Source("tzt"); got != jsonPath {
+		t.Fatalf("expected TZT to come from %s, got %q", jsonPath, got)
+	}
+	if got := ICAOCode("T7"); got != "TZT" {
+		t.Fatalf("expected the IATA index to include TZT, got %q", got)
+	}
+	if got := Search("test air"); len(got) == 0 || got[0].ICAO != "TZT" {
+		t.Fatalf("expected search to find the override, got %#v", got)
+	}
+
+	united := ByICAO("UAL")
+	if united == nil || united.Name != "United" || united.IATA != "UA" || united.Country != "United States" {
+		t.Fatalf("expected empty CSV fields to keep the embedded values, got %#v", united)
+	}
+	if got := Source("UAL"); got != csvPath {
+		t.Fatalf("expected UAL to come from %s, got %q", csvPath, got)
+	}
+}
+
+func TestLoadOverridesReportsInvalidEntries(t *testing.T) {
+	resetOverrides(t)
+	dir := t.TempDir()
+	writeOverrideFile(t, dir, "airlines.json", `[
+		{"icao": "U2", "name": "Short"},
+		{"icao": "ZZZ", "iata": "ABC", "name": "Long IATA"},
+		{"icao": "ZZY"},
+		{"icao": "ZZX", "name": "Valid"}
+	]`)
+	writeOverrideFile(t, dir, "airlines.csv", "name,iata\nNo ICAO,NI\n")
+
+	files, err := LoadOverrides(dir)
+	if err == nil || !strings.Contains(err.Error(), "icao column") {
+		t.Fatalf("expected an error for the CSV file without an icao column, got %v", err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected the JSON file to still be merged, got %#v", files)
+	}
+
+	file := files[0]
+	if file.Entries != 1 || ByICAO("ZZX") == nil {
+		t.Fatalf("expected only ZZX to be merged, got %#v", file)
+	}
+	want := []string{
+		`entry 1: invalid ICAO code "U2"`,
+		`entry 2: invalid IATA code "ABC"`,
+		`entry 3: ZZY is not in the table`,
+	}
+	if len(file.Problems) != len(want) {
+		t.Fatalf("expected %d problems, got %q", len(want), file.Problems)
+	}
+	for i, prefix := range want {
+		if !strings.HasPrefix(file.Problems[i], prefix) {
+			t.Errorf("problem %d = %q, want prefix %q", i, file.Problems[i], prefix)
+		}
+	}
+}
+
+func TestLoadOverridesActiveFlagChoosesSharedCodeCarrier(t *testing.T) {
+	resetOverrides(t)
+	dir := t.TempDir()
+	writeOverrideFile(t, dir, "airlines.csv", "icao,active\nAZU,Y\nPRZ,N\n")
+
+	if _, err := LoadOverrides(dir); err != nil {
+		t.Fatalf("LoadOverrides returned error: %v", err)
+	}
+	if got := ICAOCode("AD"); got != "AZU" {
+		t.Fatalf("expected the active flag to prefer AZU for AD, got %q", got)
+	}
+	if Ambiguous("AD") {
+		t.Fatal("expected AD to resolve to a single active carrier")
+	}
+
+	writeOverrideFile(t, dir, "airlines.csv", "icao,active\nAZU,Y\nPRZ,Y\n")
+	if _, err := LoadOverrides(dir); err != nil {
+		t.Fatalf("LoadOverrides returned error: %v", err)
+	}
+	if !Ambiguous("AD") {
+		t.Fatal("expected AD to be ambiguous with two active carriers")
+	}
+}
+
+func TestLoadOverridesReplacesEarlierLoad(t *testing.T) {
+	resetOverrides(t)
+	dir := t.TempDir()
+	writeOverrideFile(t, dir, "airlines.json", `[{"icao": "ZZX", "iata": "Z9", "name": "Valid"}]`)
+	if _, err := LoadOverrides(dir); err != nil {
+		t.Fatalf("LoadOverrides returned error: %v", err)
+	}
+
+	files, err := LoadOverrides(t.TempDir())
+	if err != nil || len(files) != 0 {
+		t.Fatalf("expected an empty directory to load nothing, got %#v, %v", files, err)
+	}
+	if ByICAO("ZZX") != nil || len(AllByIATA("Z9")) != 0 {
+		t.Fatal("expected the earlier override to be dropped")
+	}
+	if got := Source("RPA"); got != SourceBuiltIn {
+		t.Fatalf("expected RPA to be a built-in correction, got %q", got)
+	}
+	if got := Source("UAL"); got != SourceEmbedded {
+		t.Fatalf("expected UAL to come from the embedded data, got %q", got)
+	}
+	if got := Source("QQQ"); got != "" {
+		t.Fatalf("expected no source for an unknown code, got %q", got)
+	}
+}
@@ -0,0 +1,313 @@
+package airlines
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+// all returns every airline in the table with built-in corrections and
+// override files applied.
+func all() []Airline {
+	list := make([]Airline, 0, len(icaoToAirline)+len(local))
+	for icao, a := range icaoToAirline {
+		if _, ok := local[icao]; ok {
+			continue
+		}
+		if override, ok := overrides[icao]; ok {
+			a = override
+		}
+		list = append(list, a)
+	}
+	for icao, a := range overrides {
+		_, inTable := icaoToAirline[icao]
+		_, inLocal := local[icao]
+		if !inTable && !inLocal {
+			list = append(list, a)
+		}
+	}
+	for _, a := range local {
+		list = append(list, a)
+	}
+	return list
+}
+
+// iataIndex lists every airline by IATA code, ordered by sortPreferred.
+// IATA codes are reused as airlines fold and new ones start, so a code can
+// belong to several airlines in the table.
+var iataIndex = buildIATAIndex()
+
+func buildIATAIndex() map[string][]Airline {
+	index := make(map[string][]Airline)
+	for _, a := range all() {
+		if a.IATA != "" {
+			index[a.IATA] = append(index[a.IATA], a)
+		}
+	}
+	// A few generated picks are listed only under their IATA code: GI for
+	// Itek Air, whose ICAO code the table gives to Gorkha Airlines, and RW,
+	// Republic's code before the built-in correction to YX. Override files
+	// that list the airline replace the pick.
+	for iata, pick := range iataToAirline {
+		if _, ok := local[pick.ICAO]; ok {
+			continue
+		}
+		if !slices.ContainsFunc(index[iata], func(a Airline) bool { return a.ICAO == pick.ICAO }) {
+			index[iata] = append(index[iata], pick)
+		}
+	}
+	for _, list := range index {
+		sortPreferred(list)
+	}
+	return index
+}
+
+// callsignIndex lists every airline by its radio callsign, keyed by
+// callsignKey and ordered like iataIndex. A few callsigns belonged to
+// airlines that have since folded.
+var callsignIndex = buildCallsignIndex()
+
+func buildCallsignIndex() map[string][]Airline {
+	index := make(map[string][]Airline)
+	for _, a := range all() {
+		if key := callsignKey(a.Callsign); key != "" {
+			index[key] = append(index[key], a)
+		}
+	}
+	for _, list := range index {
+		sortPreferred(list)
+	}
+	return index
+}
+
+// callsignKey drops case, spaces and punctuation, so "Air Canada",
+// "AIRCANADA" and "AIR-CANADA" are the same callsign.
+func callsignKey(callsign string) string {
+	return strings.ReplaceAll(searchKey(callsign), " ", "")
+}
+
+// sortPreferred orders airlines sharing a code by preference, then
+// iataToAirline's pick for the code, then ICAO code.
+func sortPreferred(list []Airline) {
+	sort.Slice(list, func(i, j int) bool {
+		if pi, pj := preference(list[i]), preference(list[j]); pi != pj {
+			return pi < pj
+		}
+		if pi, pj := isPick(list[i]), isPick(list[j]); pi != pj {
+			return pi
+		}
+		return list[i].ICAO < list[j].ICAO
+	})
+}
+
+// How strongly an airline is preferred among those sharing a code, best
+// first.
+const (
+	markedActive   = iota // an override file marks it active
+	listedActive          // OpenFlights lists it as active, or a built-in correction
+	unranked              // no active flag is known
+	listedInactive        // OpenFlights lists it as inactive
+	markedInactive        // an override file marks it inactive
+)
+
+// preference ranks an airline among those sharing its codes by whether it
+// still flies. Built-in corrections record current operations and count as
+// active; an override file's active flag wins over the OpenFlights one.
+func preference(a Airline) int {
+	if isActive, ok := localActive[a.ICAO]; ok {
+		if isActive {
+			return markedActive
+		}
+		return markedInactive
+	}
+	if _, overridden := overrides[a.ICAO]; overridden {
+		return listedActive
+	}
+	if isActive, ok := active[a.ICAO]; ok {
+		if isActive {
+			return listedActive
+		}
+		return listedInactive
+	}
+	return unranked
+}
+
+// isPick reports whether a is the generated iataToAirline pick for its
+// code, which breaks ties between airlines preference ranks alike.
+func isPick(a Airline) bool {
+	return iataToAirline[a.IATA].ICAO == a.ICAO
+}
+
+// AllByIATA returns every airline using a 2-character IATA code, the
+// preferred one first and the rest by ICAO code.
+func AllByIATA(iata string) []Airline {
+	return slices.Clone(iataIndex[strings.ToUpper(strings.TrimSpace(iata))])
+}
+
+// ByCallsign returns the airline using a radio callsign such as
+// "SPEEDBIRD", preferring an active carrier when several share it, or nil.
+func ByCallsign(callsign string) *Airline {
+	list := callsignIndex[callsignKey(callsign)]
+	if len(list) == 0 {
+		return nil
+	}
+	a := list[0]
+	return &a
+}
+
+// Ambiguous reports whether an IATA code could mean more than one airline:
+// several airlines use it and their active flags do not single one out,
+// because several are active or all of them are inactive. Airlines without
+// a known flag fall back on iataToAirline's pick, which is not a guess.
+func Ambiguous(iata string) bool {
+	all := iataIndex[strings.ToUpper(strings.TrimSpace(iata))]
+	if len(all) < 2 {
+		return false
+	}
+	first := preference(all[0])
+	return first != unranked && first == preference(all[1])
+}
+
+// Ways a search query can match an airline, best first.
+const (
+	matchCode = iota
+	matchExact
+	matchPrefix
+	matchWord
+	matchSubstring
+	matchFuzzy
+	noMatch
+)
+
+// Search returns airlines whose name or radio callsign matches query, best
+// matches first: exact IATA or ICAO codes, then exact names or callsigns,
+// then ones that start with the query, contain a word starting with it, or
+// contain it anywhere, and finally near misses such as "untied" for
+// "United". Ties are ordered by name.
+func Search(query string) []Airline {
+	query = searchKey(query)
+	if query == "" {
+		return nil
+	}
+
+	type ranked struct {
+		airline Airline
+		rank    int
+	}
+	var matches []ranked
+	for _, a := range all() {
+		if rank := matchRank(a, query); rank != noMatch {
+			matches = append(matches, ranked{a, rank})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].rank != matches[j].rank {
+			return matches[i].rank < matches[j].rank
+		}
+		if matches[i].airline.Name != matches[j].airline.Name {
+			return matches[i].airline.Name < matches[j].airline.Name
+		}
+		return matches[i].airline.ICAO < matches[j].airline.ICAO
+	})
+
+	result := make([]Airline, len(matches))
+	for i, m := range matches {
+		result[i] = m.airline
+	}
+	return result
+}
+
+func matchRank(a Airline, query string) int {
+	if query == strings.ToLower(a.ICAO) || query == strings.ToLower(a.IATA) {
+		return matchCode
+	}
+
+	best := noMatch
+	for _, field := range []string{searchKey(a.Name), searchKey(a.Callsign)} {
+		if field == "" {
+			continue
+		}
+		rank := noMatch
+		switch {
+		case field == query:
+			rank = matchExact
+		case strings.HasPrefix(field, query):
+			rank = matchPrefix
+		case strings.Contains(" "+field, " "+query):
+			rank = matchWord
+		case len(query) < 3:
+			// Two letters appear inside too many names to be useful.
+		case strings.Contains(field, query):
+			rank = matchSubstring
+		case fuzzyMatch(field, query):
+			rank = matchFuzzy
+		}
+		best = min(best, rank)
+	}
+	return best
+}
+
+// searchKey lowercases s and turns punctuation into single spaces, so
+// "Mongol_AIr" and "mongol air" compare equal.
+func searchKey(s string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < '0' || r > '9')
+	}), " ")
+}
+
+// fuzzyMatch reports whether every word of query is close to some word of
+// field: a prefix of it, or within a typo or two of it.
+func fuzzyMatch(field, query string) bool {
+	words := strings.Fields(field)
+	for _, q := range strings.Fields(query) {
+		found := false
+		for _, w := range words {
+			if strings.HasPrefix(w, q) || editDistance(q, w) <= allowedEdits(q) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// allowedEdits is how many typos a query word of this length may contain.
+// Short words get none, or everything would match.
+func allowedEdits(word string) int {
+	switch n := len(word); {
+	case n >= 8:
+		return 2
+	case n >= 4:
+		return 1
+	}
+	return 0
+}
+
+// editDistance counts the insertions, deletions, substitutions and swaps
+// of adjacent letters needed to turn a into b.
+func editDistance(a, b string) int {
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
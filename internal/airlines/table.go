@@ -3,6 +3,10 @@
 // DO NOT EDIT by hand — regenerate if the upstream source changes.
 package airlines
 
+// active records the OpenFlights active flag (Y or N) by ICAO code. This
+// table predates the flag, so it is empty until go generate fills it in.
+var active = map[string]bool{}
+
 var icaoToAirline = map[string]Airline{
 	"AAA": {Name: "Ansett Australia", IATA: "AN", ICAO: "AAA", Callsign: "ANSETT", Country: "Australia"},
 	"AAB": {Name: "Abelag Aviation", IATA: "W9", ICAO: "AAB", Callsign: "ABG", Country: "Belgium"},
@@ -1184,17 +1188,6 @@ var icaoToAirline = map[string]Airline{
 	"ZZZ": {Name: "Zabaykalskii Airlines", IATA: "ZP", ICAO: "ZZZ", Callsign: "Lakeair", Country: "Russia"},
 }
 
-// overrides amends OpenFlights entries where the current operational
-// IATA code differs from what OpenFlights records or where CSV parsing
-// corrupted metadata.
-var overrides = map[string]Airline{
-	"ASA": {Name: "Alaska Airlines", IATA: "AS", ICAO: "ASA", Callsign: "ALASKA", Country: "United States"},
-	"AVA": {Name: "Avianca", IATA: "AV", ICAO: "AVA", Callsign: "AVIANCA", Country: "Colombia"},
-	"EQL": {Name: "Air S", IATA: "KY", ICAO: "EQL", Callsign: "EQUATORIAL", Country: "Equatorial Guinea"},
-	"HDA": {Name: "Dragonair", IATA: "KA", ICAO: "HDA", Callsign: "DRAGON", Country: "Hong Kong"},
-	"RPA": {Name: "Republic Airways", IATA: "YX", ICAO: "RPA", Callsign: "BRICKYARD", Country: "United States"},
-}
-
 var iataToAirline = map[string]Airline{
 	"04": {Name: "Antrak Air", IATA: "04", ICAO: "ABV", Callsign: "ANTRAK", Country: "Ghana"},
 	"0A": {Name: "Amber Air", IATA: "0A", ICAO: "GNT", Callsign: "GINTA", Country: "Lithuania"},
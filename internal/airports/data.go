@@ -0,0 +1,164 @@
+// Package airports provides an embedded table of major commercial airports
+// in the layout of the OpenFlights airports database, keyed by IATA code.
+//
+// Data source: OpenFlights Airports Database, curated to major airports
+// https://github.com/jpatokal/openflights/blob/master/data/airports.dat
+// License: Open Database License (ODbL) — see NOTICE.txt
+//
+// Until it is regenerated with go generate, the table is not a complete
+// list of airports: a code missing from it may still be valid.
+package airports
+
+//go:generate go run gen.go
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	// Embed the time zone database so Location works on Windows and in
+	// minimal containers without one installed.
+	_ "time/tzdata"
+)
+
+// Airport holds metadata for a single airport.
+type Airport struct {
+	IATA      string  // 3-letter IATA code (e.g. "JFK")
+	ICAO      string  // 4-letter ICAO code (e.g. "KJFK")
+	Name      string  // Airport name
+	City      string  // Main city served
+	Country   string  // Country or territory
+	Latitude  float64 // Decimal degrees, north positive
+	Longitude float64 // Decimal degrees, east positive
+	Timezone  string  // IANA time zone (e.g. "America/New_York")
+}
+
+// icaoToAirport indexes iataToAirport by ICAO code.
+var icaoToAirport = func() map[string]Airport {
+	byICAO := make(map[string]Airport, len(iataToAirport))
+	for _, a := range iataToAirport {
+		byICAO[a.ICAO] = a
+	}
+	return byICAO
+}()
+
+// Location returns the airport's time zone.
+func (a Airport) Location() (*time.Location, error) {
+	return time.LoadLocation(a.Timezone)
+}
+
+// ByIATA returns airport metadata for a 3-letter IATA code, or nil.
+func ByIATA(iata string) *Airport {
+	if a, ok := iataToAirport[strings.ToUpper(strings.TrimSpace(iata))]; ok {
+		return &a
+	}
+	return nil
+}
+
+// ByICAO returns airport metadata for a 4-letter ICAO code, or nil.
+func ByICAO(icao string) *Airport {
+	if a, ok := icaoToAirport[strings.ToUpper(strings.TrimSpace(icao))]; ok {
+		return &a
+	}
+	return nil
+}
+
+// Name returns the airport name for an IATA code, or "" if not found.
+func Name(iata string) string {
+	if a := ByIATA(iata); a != nil {
+		return a.Name
+	}
+	return ""
+}
+
+// Unknown reports whether an IATA code is missing from a table complete
+// enough to rule it out, so it can be refused before any API call. The
+// curated table leaves out smaller airports and rules nothing out; the
+// generated one lists every OpenFlights airport with an IATA code.
+func Unknown(iata string) bool {
+	return complete && ByIATA(iata) == nil
+}
+
+// Suggest returns the known airport a mistyped IATA code was probably meant
+// to be, or nil. Only codes missing from the table whose letters are an
+// adjacent swap of a known code count (e.g. "JKF" for "JFK"). Offer it when
+// refusing a code Unknown reports, or as a hint after a lookup finds
+// nothing.
+func Suggest(iata string) *Airport {
+	iata = strings.ToUpper(strings.TrimSpace(iata))
+	if len(iata) != 3 || ByIATA(iata) != nil {
+		return nil
+	}
+	for i := 0; i+1 < len(iata); i++ {
+		if iata[i] == iata[i+1] {
+			continue
+		}
+		swapped := iata[:i] + string(iata[i+1]) + string(iata[i]) + iata[i+2:]
+		if a := ByIATA(swapped); a != nil {
+			return a
+		}
+	}
+	return nil
+}
+
+// Ways a search query can match an airport, best first.
+const (
+	matchCode = iota
+	matchCity
+	matchPrefix
+	matchWord
+	matchSubstring
+	noMatch
+)
+
+// Search returns airports whose code, name or city matches query, best
+// matches first: exact IATA or ICAO codes, then exact cities, then names or
+// cities that start with the query, contain a word starting with it, or
+// contain it anywhere. Ties are ordered by IATA code.
+func Search(query string) []Airport {
+	query = strings.ToLower(strings.Join(strings.Fields(query), " "))
+	if query == "" {
+		return nil
+	}
+
+	type ranked struct {
+		airport Airport
+		rank    int
+	}
+	var matches []ranked
+	for _, a := range iataToAirport {
+		if rank := matchRank(a, query); rank != noMatch {
+			matches = append(matches, ranked{a, rank})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].rank != matches[j].rank {
+			return matches[i].rank < matches[j].rank
+		}
+		return matches[i].airport.IATA < matches[j].airport.IATA
+	})
+
+	result := make([]Airport, len(matches))
+	for i, m := range matches {
+		result[i] = m.airport
+	}
+	return result
+}
+
+func matchRank(a Airport, query string) int {
+	name := strings.ToLower(a.Name)
+	city := strings.ToLower(a.City)
+	switch {
+	case query == strings.ToLower(a.IATA) || query == strings.ToLower(a.ICAO):
+		return matchCode
+	case query == city:
+		return matchCity
+	case strings.HasPrefix(name, query) || strings.HasPrefix(city, query):
+		return matchPrefix
+	case strings.Contains(" "+name, " "+query) || strings.Contains(" "+city, " "+query):
+		return matchWord
+	case strings.Contains(name, query) || strings.Contains(city, query):
+		return matchSubstring
+	}
+	return noMatch
+}
@@ -0,0 +1,137 @@
+package airports
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEmbeddedDatasetInvariants(t *testing.T) {
+	for iata, airport := range iataToAirport {
+		if len(iata) != 3 {
+			t.Errorf("IATA key %q has length %d, want 3", iata, len(iata))
+		}
+		for _, c := range iata {
+			if c < 'A' || c > 'Z' {
+				t.Errorf("IATA key %q contains non-A-Z rune %q", iata, string(c))
+			}
+		}
+		if airport.IATA != iata {
+			t.Errorf("IATA key %q points to airport IATA %q", iata, airport.IATA)
+		}
+		if len(airport.ICAO) != 4 {
+			t.Errorf("airport %q has ICAO %q, want 4 letters", iata, airport.ICAO)
+		}
+		if airport.Name == "" || airport.City == "" || airport.Country == "" {
+			t.Errorf("airport %q has empty required fields: %#v", iata, airport)
+		}
+		if airport.Latitude < -90 || airport.Latitude > 90 || airport.Longitude < -180 || airport.Longitude > 180 {
+			t.Errorf("airport %q has out-of-range coordinates %v, %v", iata, airport.Latitude, airport.Longitude)
+		}
+		if _, err := time.LoadLocation(airport.Timezone); err != nil {
+			t.Errorf("airport %q has unknown time zone %q: %v", iata, airport.Timezone, err)
+		}
+	}
+	if len(icaoToAirport) != len(iataToAirport) {
+		t.Fatalf("expected one airport per ICAO code, got %d ICAO codes for %d airports", len(icaoToAirport), len(iataToAirport))
+	}
+}
+
+func TestLookupByIATAAndICAO(t *testing.T) {
+	jfk := ByIATA(" jfk ")
+	if jfk == nil || jfk.ICAO != "KJFK" || jfk.City != "New York" || jfk.Timezone != "America/New_York" {
+		t.Fatalf("unexpected JFK lookup: %#v", jfk)
+	}
+	if lhr := ByICAO("egll"); lhr == nil || lhr.IATA != "LHR" {
+		t.Fatalf("expected EGLL to resolve to LHR, got %#v", lhr)
+	}
+	if got := Name("SIN"); got != "Singapore Changi Airport" {
+		t.Fatalf("expected SIN name, got %q", got)
+	}
+	if ByIATA("QQQ") != nil || Name("QQQ") != "" {
+		t.Fatal("expected unknown code to return nil")
+	}
+}
+
+func TestSuggestCatchesSwappedLetters(t *testing.T) {
+	tests := []struct {
+		code string
+		want string
+	}{
+		{code: "JKF", want: "JFK"},
+		{code: "lxa", want: "LAX"},
+		{code: "JFK", want: ""},
+		{code: "QQQ", want: ""},
+		{code: "JF", want: ""},
+	}
+
+	for _, tt := range tests {
+		got := ""
+		if a := Suggest(tt.code); a != nil {
+			got = a.IATA
+		}
+		if got != tt.want {
+			t.Errorf("Suggest(%q) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestUnknownOnlyRulesOutCodesOnceTheTableIsComplete(t *testing.T) {
+	original := complete
+	t.Cleanup(func() { complete = original })
+
+	complete = false
+	if Unknown("ALS") || Unknown("JKF") {
+		t.Fatal("expected the curated table to rule no code out")
+	}
+
+	complete = true
+	if !Unknown("JKF") || !Unknown(" jkf ") {
+		t.Fatal("expected a code missing from the full table to be unknown")
+	}
+	if Unknown("jfk") {
+		t.Fatal("expected a listed code to be known")
+	}
+}
+
+func TestSearchRanksCodesCitiesAndNames(t *testing.T) {
+	tests := []struct {
+		query string
+		first string
+	}{
+		{query: "heathrow", first: "LHR"},
+		{query: "KJFK", first: "JFK"},
+		{query: "london", first: "LCY"},
+		{query: "  Changi ", first: "SIN"},
+		{query: "kennedy", first: "JFK"},
+	}
+
+	for _, tt := range tests {
+		results := Search(tt.query)
+		if len(results) == 0 || results[0].IATA != tt.first {
+			t.Errorf("Search(%q) = %v, want %s first", tt.query, codes(results), tt.first)
+		}
+	}
+
+	london := codes(Search("London"))
+	for _, want := range []string{"LHR", "LGW", "STN", "LTN", "LCY"} {
+		found := false
+		for _, code := range london {
+			found = found || code == want
+		}
+		if !found {
+			t.Errorf("expected %s among London airports, got %v", want, london)
+		}
+	}
+
+	if got := Search("   "); got != nil {
+		t.Fatalf("expected no results for a blank query, got %v", codes(got))
+	}
+}
+
+func codes(airports []Airport) []string {
+	out := make([]string, len(airports))
+	for i, a := range airports {
+		out[i] = a.IATA
+	}
+	return out
+}
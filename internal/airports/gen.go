@@ -0,0 +1,185 @@
+//go:build ignore
+
+// gen.go regenerates table.go from the OpenFlights airports.dat file,
+// keeping every airport with both an IATA and an ICAO code:
+//
+//	go generate ./internal/airports
+//	go run gen.go -in airports.dat   # from a local copy
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const sourceURL = "https://raw.githubusercontent.com/jpatokal/openflights/master/data/airports.dat"
+
+// Columns of airports.dat.
+const (
+	colName = 1 + iota
+	colCity
+	colCountry
+	colIATA
+	colICAO
+	colLatitude
+	colLongitude
+	colAltitude
+	colOffset
+	colDST
+	colTimezone
+	minColumns
+)
+
+type airport struct {
+	IATA, ICAO, Name, City, Country string
+	Latitude, Longitude             string
+	Timezone                        string
+}
+
+func main() {
+	in := flag.String("in", "", "read airports.dat from this file instead of "+sourceURL)
+	out := flag.String("out", "table.go", "file to write")
+	flag.Parse()
+
+	data, err := readSource(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	table, err := parse(data)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := render(table)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("wrote %d airports to %s", len(table), *out)
+}
+
+func readSource(path string) ([]byte, error) {
+	if path != "" {
+		return os.ReadFile(path)
+	}
+	resp, err := http.Get(sourceURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", sourceURL, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// parse keeps the first airport listed for each IATA and ICAO code, and
+// skips rows the package could not use: missing codes, names or
+// coordinates, or a time zone Go does not know.
+func parse(data []byte) ([]airport, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+
+	seenIATA := map[string]bool{}
+	seenICAO := map[string]bool{}
+	var table []airport
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(row) < minColumns {
+			continue
+		}
+		a := airport{
+			IATA:      field(row[colIATA]),
+			ICAO:      field(row[colICAO]),
+			Name:      field(row[colName]),
+			City:      field(row[colCity]),
+			Country:   field(row[colCountry]),
+			Latitude:  field(row[colLatitude]),
+			Longitude: field(row[colLongitude]),
+			Timezone:  field(row[colTimezone]),
+		}
+		if !isCode(a.IATA, 3) || !isCode(a.ICAO, 4) || seenIATA[a.IATA] || seenICAO[a.ICAO] {
+			continue
+		}
+		if a.Name == "" || a.City == "" || a.Country == "" {
+			continue
+		}
+		if !inRange(a.Latitude, 90) || !inRange(a.Longitude, 180) {
+			continue
+		}
+		if _, err := time.LoadLocation(a.Timezone); a.Timezone == "" || err != nil {
+			continue
+		}
+		seenIATA[a.IATA] = true
+		seenICAO[a.ICAO] = true
+		table = append(table, a)
+	}
+	sort.Slice(table, func(i, j int) bool { return table[i].IATA < table[j].IATA })
+	return table, nil
+}
+
+// field trims a value and maps the \N null marker to "".
+func field(s string) string {
+	s = strings.TrimSpace(s)
+	if s == `\N` {
+		return ""
+	}
+	return s
+}
+
+func isCode(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for _, c := range s {
+		if (c < 'A' || c > 'Z') && (n == 3 || c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func inRange(s string, limit float64) bool {
+	v, err := strconv.ParseFloat(s, 64)
+	return err == nil && v >= -limit && v <= limit
+}
+
+func render(table []airport) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(`// Code generated by gen.go from the OpenFlights airports.dat file; DO NOT EDIT.
+// Airports dataset (ODbL license):
+// https://github.com/jpatokal/openflights/blob/master/data/airports.dat
+
+package airports
+
+// complete marks the table as listing every airport with an IATA code.
+var complete = true
+
+var iataToAirport = map[string]Airport{
+`)
+	for _, a := range table {
+		fmt.Fprintf(&b, "\t%q: {IATA: %q, ICAO: %q, Name: %q, City: %q, Country: %q, Latitude: %s, Longitude: %s, Timezone: %q},\n",
+			a.IATA, a.IATA, a.ICAO, a.Name, a.City, a.Country, a.Latitude, a.Longitude, a.Timezone)
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,394 @@
+// Airports dataset in the OpenFlights airports.dat layout (ODbL license),
+// curated by hand to major commercial airports. Run go generate in this
+// directory to replace it with every airport in the upstream file.
+// https://github.com/jpatokal/openflights/blob/master/data/airports.dat
+package airports
+
+// complete is false while the table leaves out smaller airports: a code
+// missing from it may still be genuine.
+var complete = false
+
+var iataToAirport = map[string]Airport{
+	"ABJ": {IATA: "ABJ", ICAO: "DIAP", Name: "Felix Houphouet Boigny International Airport", City: "Abidjan", Country: "Cote d'Ivoire", Latitude: 5.26139, Longitude: -3.92629, Timezone: "Africa/Abidjan"},
+	"ABQ": {IATA: "ABQ", ICAO: "KABQ", Name: "Albuquerque International Sunport", City: "Albuquerque", Country: "United States", Latitude: 35.0402, Longitude: -106.6090, Timezone: "America/Denver"},
+	"ABV": {IATA: "ABV", ICAO: "DNAA", Name: "Nnamdi Azikiwe International Airport", City: "Abuja", Country: "Nigeria", Latitude: 9.00679, Longitude: 7.26317, Timezone: "Africa/Lagos"},
+	"ACC": {IATA: "ACC", ICAO: "DGAA", Name: "Kotoka International Airport", City: "Accra", Country: "Ghana", Latitude: 5.60519, Longitude: -0.166786, Timezone: "Africa/Accra"},
+	"ADB": {IATA: "ADB", ICAO: "LTBJ", Name: "Adnan Menderes International Airport", City: "Izmir", Country: "Turkey", Latitude: 38.2924, Longitude: 27.1570, Timezone: "Europe/Istanbul"},
+	"ADD": {IATA: "ADD", ICAO: "HAAB", Name: "Addis Ababa Bole International Airport", City: "Addis Ababa", Country: "Ethiopia", Latitude: 8.97789, Longitude: 38.7993, Timezone: "Africa/Addis_Ababa"},
+	"ADL": {IATA: "ADL", ICAO: "YPAD", Name: "Adelaide International Airport", City: "Adelaide", Country: "Australia", Latitude: -34.9450, Longitude: 138.531, Timezone: "Australia/Adelaide"},
+	"AEP": {IATA: "AEP", ICAO: "SABE", Name: "Jorge Newbery Airpark", City: "Buenos Aires", Country: "Argentina", Latitude: -34.5592, Longitude: -58.4156, Timezone: "America/Argentina/Buenos_Aires"},
+	"AGP": {IATA: "AGP", ICAO: "LEMG", Name: "Malaga Airport", City: "Malaga", Country: "Spain", Latitude: 36.6749, Longitude: -4.49911, Timezone: "Europe/Madrid"},
+	"AKL": {IATA: "AKL", ICAO: "NZAA", Name: "Auckland International Airport", City: "Auckland", Country: "New Zealand", Latitude: -37.0081, Longitude: 174.792, Timezone: "Pacific/Auckland"},
+	"ALA": {IATA: "ALA", ICAO: "UAAA", Name: "Almaty Airport", City: "Almaty", Country: "Kazakhstan", Latitude: 43.3521, Longitude: 77.0405, Timezone: "Asia/Almaty"},
+	"ALB": {IATA: "ALB", ICAO: "KALB", Name: "Albany International Airport", City: "Albany", Country: "United States", Latitude: 42.7483, Longitude: -73.8017, Timezone: "America/New_York"},
+	"ALC": {IATA: "ALC", ICAO: "LEAL", Name: "Alicante International Airport", City: "Alicante", Country: "Spain", Latitude: 38.2822, Longitude: -0.558156, Timezone: "Europe/Madrid"},
+	"ALG": {IATA: "ALG", ICAO: "DAAG", Name: "Houari Boumediene Airport", City: "Algier", Country: "Algeria", Latitude: 36.6910, Longitude: 3.21541, Timezone: "Africa/Algiers"},
+	"AMD": {IATA: "AMD", ICAO: "VAAH", Name: "Sardar Vallabhbhai Patel International Airport", City: "Ahmedabad", Country: "India", Latitude: 23.0772, Longitude: 72.6347, Timezone: "Asia/Kolkata"},
+	"AMM": {IATA: "AMM", ICAO: "OJAI", Name: "Queen Alia International Airport", City: "Amman", Country: "Jordan", Latitude: 31.7226, Longitude: 35.9932, Timezone: "Asia/Amman"},
+	"AMS": {IATA: "AMS", ICAO: "EHAM", Name: "Amsterdam Airport Schiphol", City: "Amsterdam", Country: "Netherlands", Latitude: 52.3086, Longitude: 4.76389, Timezone: "Europe/Amsterdam"},
+	"ANC": {IATA: "ANC", ICAO: "PANC", Name: "Ted Stevens Anchorage International Airport", City: "Anchorage", Country: "United States", Latitude: 61.1744, Longitude: -149.9960, Timezone: "America/Anchorage"},
+	"ARN": {IATA: "ARN", ICAO: "ESSA", Name: "Stockholm-Arlanda Airport", City: "Stockholm", Country: "Sweden", Latitude: 59.6519, Longitude: 17.9186, Timezone: "Europe/Stockholm"},
+	"ASU": {IATA: "ASU", ICAO: "SGAS", Name: "Silvio Pettirossi International Airport", City: "Asuncion", Country: "Paraguay", Latitude: -25.2400, Longitude: -57.5200, Timezone: "America/Asuncion"},
+	"ATH": {IATA: "ATH", ICAO: "LGAV", Name: "Eleftherios Venizelos International Airport", City: "Athens", Country: "Greece", Latitude: 37.9364, Longitude: 23.9445, Timezone: "Europe/Athens"},
+	"ATL": {IATA: "ATL", ICAO: "KATL", Name: "Hartsfield Jackson Atlanta International Airport", City: "Atlanta", Country: "United States", Latitude: 33.6367, Longitude: -84.4281, Timezone: "America/New_York"},
+	"AUA": {IATA: "AUA", ICAO: "TNCA", Name: "Queen Beatrix International Airport", City: "Oranjestad", Country: "Aruba", Latitude: 12.5014, Longitude: -70.0152, Timezone: "America/Aruba"},
+	"AUH": {IATA: "AUH", ICAO: "OMAA", Name: "Zayed International Airport", City: "Abu Dhabi", Country: "United Arab Emirates", Latitude: 24.4330, Longitude: 54.6511, Timezone: "Asia/Dubai"},
+	"AUS": {IATA: "AUS", ICAO: "KAUS", Name: "Austin Bergstrom International Airport", City: "Austin", Country: "United States", Latitude: 30.1945, Longitude: -97.6699, Timezone: "America/Chicago"},
+	"AYT": {IATA: "AYT", ICAO: "LTAI", Name: "Antalya International Airport", City: "Antalya", Country: "Turkey", Latitude: 36.8987, Longitude: 30.8005, Timezone: "Europe/Istanbul"},
+	"BAH": {IATA: "BAH", ICAO: "OBBI", Name: "Bahrain International Airport", City: "Bahrain", Country: "Bahrain", Latitude: 26.2708, Longitude: 50.6336, Timezone: "Asia/Bahrain"},
+	"BCN": {IATA: "BCN", ICAO: "LEBL", Name: "Barcelona International Airport", City: "Barcelona", Country: "Spain", Latitude: 41.2971, Longitude: 2.07846, Timezone: "Europe/Madrid"},
+	"BDA": {IATA: "BDA", ICAO: "TXKF", Name: "L.F. Wade International International Airport", City: "Bermuda", Country: "Bermuda", Latitude: 32.3640, Longitude: -64.6787, Timezone: "Atlantic/Bermuda"},
+	"BDL": {IATA: "BDL", ICAO: "KBDL", Name: "Bradley International Airport", City: "Windsor Locks", Country: "United States", Latitude: 41.9389, Longitude: -72.6832, Timezone: "America/New_York"},
+	"BEG": {IATA: "BEG", ICAO: "LYBE", Name: "Belgrade Nikola Tesla Airport", City: "Belgrade", Country: "Serbia", Latitude: 44.8184, Longitude: 20.3091, Timezone: "Europe/Belgrade"},
+	"BER": {IATA: "BER", ICAO: "EDDB", Name: "Berlin Brandenburg Airport", City: "Berlin", Country: "Germany", Latitude: 52.3514, Longitude: 13.4939, Timezone: "Europe/Berlin"},
+	"BEY": {IATA: "BEY", ICAO: "OLBA", Name: "Beirut Rafic Hariri International Airport", City: "Beirut", Country: "Lebanon", Latitude: 33.8209, Longitude: 35.4884, Timezone: "Asia/Beirut"},
+	"BFS": {IATA: "BFS", ICAO: "EGAA", Name: "Belfast International Airport", City: "Belfast", Country: "United Kingdom", Latitude: 54.6575, Longitude: -6.21583, Timezone: "Europe/London"},
+	"BGI": {IATA: "BGI", ICAO: "TBPB", Name: "Grantley Adams International Airport", City: "Bridgetown", Country: "Barbados", Latitude: 13.0746, Longitude: -59.4925, Timezone: "America/Barbados"},
+	"BGO": {IATA: "BGO", ICAO: "ENBR", Name: "Bergen Airport Flesland", City: "Bergen", Country: "Norway", Latitude: 60.2934, Longitude: 5.21814, Timezone: "Europe/Oslo"},
+	"BGY": {IATA: "BGY", ICAO: "LIME", Name: "Il Caravaggio International Airport", City: "Bergamo", Country: "Italy", Latitude: 45.6739, Longitude: 9.70417, Timezone: "Europe/Rome"},
+	"BHM": {IATA: "BHM", ICAO: "KBHM", Name: "Birmingham-Shuttlesworth International Airport", City: "Birmingham", Country: "United States", Latitude: 33.5629, Longitude: -86.7535, Timezone: "America/Chicago"},
+	"BHX": {IATA: "BHX", ICAO: "EGBB", Name: "Birmingham International Airport", City: "Birmingham", Country: "United Kingdom", Latitude: 52.4539, Longitude: -1.74803, Timezone: "Europe/London"},
+	"BIO": {IATA: "BIO", ICAO: "LEBB", Name: "Bilbao Airport", City: "Bilbao", Country: "Spain", Latitude: 43.3011, Longitude: -2.91061, Timezone: "Europe/Madrid"},
+	"BKI": {IATA: "BKI", ICAO: "WBKK", Name: "Kota Kinabalu International Airport", City: "Kota Kinabalu", Country: "Malaysia", Latitude: 5.93721, Longitude: 116.051, Timezone: "Asia/Kuala_Lumpur"},
+	"BKK": {IATA: "BKK", ICAO: "VTBS", Name: "Suvarnabhumi Airport", City: "Bangkok", Country: "Thailand", Latitude: 13.6811, Longitude: 100.747, Timezone: "Asia/Bangkok"},
+	"BLL": {IATA: "BLL", ICAO: "EKBI", Name: "Billund Airport", City: "Billund", Country: "Denmark", Latitude: 55.7403, Longitude: 9.15178, Timezone: "Europe/Copenhagen"},
+	"BLQ": {IATA: "BLQ", ICAO: "LIPE", Name: "Bologna Guglielmo Marconi Airport", City: "Bologna", Country: "Italy", Latitude: 44.5354, Longitude: 11.2887, Timezone: "Europe/Rome"},
+	"BLR": {IATA: "BLR", ICAO: "VOBL", Name: "Kempegowda International Airport", City: "Bangalore", Country: "India", Latitude: 13.1979, Longitude: 77.7063, Timezone: "Asia/Kolkata"},
+	"BNA": {IATA: "BNA", ICAO: "KBNA", Name: "Nashville International Airport", City: "Nashville", Country: "United States", Latitude: 36.1245, Longitude: -86.6782, Timezone: "America/Chicago"},
+	"BNE": {IATA: "BNE", ICAO: "YBBN", Name: "Brisbane International Airport", City: "Brisbane", Country: "Australia", Latitude: -27.3842, Longitude: 153.117, Timezone: "Australia/Brisbane"},
+	"BOD": {IATA: "BOD", ICAO: "LFBD", Name: "Bordeaux-Merignac Airport", City: "Bordeaux", Country: "France", Latitude: 44.8283, Longitude: -0.715556, Timezone: "Europe/Paris"},
+	"BOG": {IATA: "BOG", ICAO: "SKBO", Name: "El Dorado International Airport", City: "Bogota", Country: "Colombia", Latitude: 4.70159, Longitude: -74.1469, Timezone: "America/Bogota"},
+	"BOI": {IATA: "BOI", ICAO: "KBOI", Name: "Boise Air Terminal/Gowen Field", City: "Boise", Country: "United States", Latitude: 43.5644, Longitude: -116.2230, Timezone: "America/Boise"},
+	"BOM": {IATA: "BOM", ICAO: "VABB", Name: "Chhatrapati Shivaji International Airport", City: "Mumbai", Country: "India", Latitude: 19.0887, Longitude: 72.8679, Timezone: "Asia/Kolkata"},
+	"BOS": {IATA: "BOS", ICAO: "KBOS", Name: "General Edward Lawrence Logan International Airport", City: "Boston", Country: "United States", Latitude: 42.3643, Longitude: -71.0052, Timezone: "America/New_York"},
+	"BRS": {IATA: "BRS", ICAO: "EGGD", Name: "Bristol Airport", City: "Bristol", Country: "United Kingdom", Latitude: 51.3827, Longitude: -2.71909, Timezone: "Europe/London"},
+	"BRU": {IATA: "BRU", ICAO: "EBBR", Name: "Brussels Airport", City: "Brussels", Country: "Belgium", Latitude: 50.9014, Longitude: 4.48444, Timezone: "Europe/Brussels"},
+	"BSB": {IATA: "BSB", ICAO: "SBBR", Name: "Presidente Juscelino Kubistschek International Airport", City: "Brasilia", Country: "Brazil", Latitude: -15.8692, Longitude: -47.9208, Timezone: "America/Sao_Paulo"},
+	"BSL": {IATA: "BSL", ICAO: "LFSB", Name: "EuroAirport Basel-Mulhouse-Freiburg Airport", City: "Mulhouse", Country: "France", Latitude: 47.5896, Longitude: 7.52991, Timezone: "Europe/Paris"},
+	"BUD": {IATA: "BUD", ICAO: "LHBP", Name: "Budapest Liszt Ferenc International Airport", City: "Budapest", Country: "Hungary", Latitude: 47.4369, Longitude: 19.2556, Timezone: "Europe/Budapest"},
+	"BUF": {IATA: "BUF", ICAO: "KBUF", Name: "Buffalo Niagara International Airport", City: "Buffalo", Country: "United States", Latitude: 42.9405, Longitude: -78.7322, Timezone: "America/New_York"},
+	"BUR": {IATA: "BUR", ICAO: "KBUR", Name: "Bob Hope Airport", City: "Burbank", Country: "United States", Latitude: 34.2007, Longitude: -118.3590, Timezone: "America/Los_Angeles"},
+	"BWI": {IATA: "BWI", ICAO: "KBWI", Name: "Baltimore/Washington International Thurgood Marshall Airport", City: "Baltimore", Country: "United States", Latitude: 39.1754, Longitude: -76.6683, Timezone: "America/New_York"},
+	"CAI": {IATA: "CAI", ICAO: "HECA", Name: "Cairo International Airport", City: "Cairo", Country: "Egypt", Latitude: 30.1219, Longitude: 31.4056, Timezone: "Africa/Cairo"},
+	"CAN": {IATA: "CAN", ICAO: "ZGGG", Name: "Guangzhou Baiyun International Airport", City: "Guangzhou", Country: "China", Latitude: 23.3924, Longitude: 113.299, Timezone: "Asia/Shanghai"},
+	"CBR": {IATA: "CBR", ICAO: "YSCB", Name: "Canberra International Airport", City: "Canberra", Country: "Australia", Latitude: -35.3069, Longitude: 149.195, Timezone: "Australia/Sydney"},
+	"CCS": {IATA: "CCS", ICAO: "SVMI", Name: "Simon Bolivar International Airport", City: "Caracas", Country: "Venezuela", Latitude: 10.6031, Longitude: -66.9906, Timezone: "America/Caracas"},
+	"CCU": {IATA: "CCU", ICAO: "VECC", Name: "Netaji Subhash Chandra Bose International Airport", City: "Kolkata", Country: "India", Latitude: 22.6547, Longitude: 88.4467, Timezone: "Asia/Kolkata"},
+	"CDG": {IATA: "CDG", ICAO: "LFPG", Name: "Charles de Gaulle International Airport", City: "Paris", Country: "France", Latitude: 49.0128, Longitude: 2.55000, Timezone: "Europe/Paris"},
+	"CEB": {IATA: "CEB", ICAO: "RPVM", Name: "Mactan Cebu International Airport", City: "Cebu", Country: "Philippines", Latitude: 10.3075, Longitude: 123.979, Timezone: "Asia/Manila"},
+	"CGH": {IATA: "CGH", ICAO: "SBSP", Name: "Congonhas Airport", City: "Sao Paulo", Country: "Brazil", Latitude: -23.6261, Longitude: -46.6564, Timezone: "America/Sao_Paulo"},
+	"CGK": {IATA: "CGK", ICAO: "WIII", Name: "Soekarno-Hatta International Airport", City: "Jakarta", Country: "Indonesia", Latitude: -6.12557, Longitude: 106.656, Timezone: "Asia/Jakarta"},
+	"CGN": {IATA: "CGN", ICAO: "EDDK", Name: "Cologne Bonn Airport", City: "Cologne", Country: "Germany", Latitude: 50.8659, Longitude: 7.14274, Timezone: "Europe/Berlin"},
+	"CHC": {IATA: "CHC", ICAO: "NZCH", Name: "Christchurch International Airport", City: "Christchurch", Country: "New Zealand", Latitude: -43.4894, Longitude: 172.532, Timezone: "Pacific/Auckland"},
+	"CHS": {IATA: "CHS", ICAO: "KCHS", Name: "Charleston Air Force Base-International Airport", City: "Charleston", Country: "United States", Latitude: 32.8986, Longitude: -80.0405, Timezone: "America/New_York"},
+	"CIA": {IATA: "CIA", ICAO: "LIRA", Name: "Ciampino-G. B. Pastine International Airport", City: "Rome", Country: "Italy", Latitude: 41.7994, Longitude: 12.5949, Timezone: "Europe/Rome"},
+	"CJU": {IATA: "CJU", ICAO: "RKPC", Name: "Jeju International Airport", City: "Jeju", Country: "South Korea", Latitude: 33.5113, Longitude: 126.493, Timezone: "Asia/Seoul"},
+	"CKG": {IATA: "CKG", ICAO: "ZUCK", Name: "Chongqing Jiangbei International Airport", City: "Chongqing", Country: "China", Latitude: 29.7192, Longitude: 106.642, Timezone: "Asia/Shanghai"},
+	"CLE": {IATA: "CLE", ICAO: "KCLE", Name: "Cleveland Hopkins International Airport", City: "Cleveland", Country: "United States", Latitude: 41.4117, Longitude: -81.8498, Timezone: "America/New_York"},
+	"CLT": {IATA: "CLT", ICAO: "KCLT", Name: "Charlotte Douglas International Airport", City: "Charlotte", Country: "United States", Latitude: 35.2140, Longitude: -80.9431, Timezone: "America/New_York"},
+	"CMB": {IATA: "CMB", ICAO: "VCBI", Name: "Bandaranaike International Colombo Airport", City: "Colombo", Country: "Sri Lanka", Latitude: 7.18076, Longitude: 79.8841, Timezone: "Asia/Colombo"},
+	"CMH": {IATA: "CMH", ICAO: "KCMH", Name: "John Glenn Columbus International Airport", City: "Columbus", Country: "United States", Latitude: 39.9980, Longitude: -82.8919, Timezone: "America/New_York"},
+	"CMN": {IATA: "CMN", ICAO: "GMMN", Name: "Mohammed V International Airport", City: "Casablanca", Country: "Morocco", Latitude: 33.3675, Longitude: -7.58997, Timezone: "Africa/Casablanca"},
+	"CNF": {IATA: "CNF", ICAO: "SBCF", Name: "Tancredo Neves International Airport", City: "Belo Horizonte", Country: "Brazil", Latitude: -19.6244, Longitude: -43.9719, Timezone: "America/Sao_Paulo"},
+	"CNS": {IATA: "CNS", ICAO: "YBCS", Name: "Cairns International Airport", City: "Cairns", Country: "Australia", Latitude: -16.8858, Longitude: 145.755, Timezone: "Australia/Brisbane"},
+	"CNX": {IATA: "CNX", ICAO: "VTCC", Name: "Chiang Mai International Airport", City: "Chiang Mai", Country: "Thailand", Latitude: 18.7668, Longitude: 98.9626, Timezone: "Asia/Bangkok"},
+	"COK": {IATA: "COK", ICAO: "VOCI", Name: "Cochin International Airport", City: "Kochi", Country: "India", Latitude: 10.1520, Longitude: 76.4019, Timezone: "Asia/Kolkata"},
+	"COR": {IATA: "COR", ICAO: "SACO", Name: "Ingeniero Ambrosio Taravella Airport", City: "Cordoba", Country: "Argentina", Latitude: -31.3236, Longitude: -64.2080, Timezone: "America/Argentina/Cordoba"},
+	"CPH": {IATA: "CPH", ICAO: "EKCH", Name: "Copenhagen Kastrup Airport", City: "Copenhagen", Country: "Denmark", Latitude: 55.6179, Longitude: 12.6560, Timezone: "Europe/Copenhagen"},
+	"CPT": {IATA: "CPT", ICAO: "FACT", Name: "Cape Town International Airport", City: "Cape Town", Country: "South Africa", Latitude: -33.9648, Longitude: 18.6017, Timezone: "Africa/Johannesburg"},
+	"CRL": {IATA: "CRL", ICAO: "EBCI", Name: "Brussels South Charleroi Airport", City: "Charleroi", Country: "Belgium", Latitude: 50.4592, Longitude: 4.45382, Timezone: "Europe/Brussels"},
+	"CTA": {IATA: "CTA", ICAO: "LICC", Name: "Catania-Fontanarossa Airport", City: "Catania", Country: "Italy", Latitude: 37.4668, Longitude: 15.0664, Timezone: "Europe/Rome"},
+	"CTG": {IATA: "CTG", ICAO: "SKCG", Name: "Rafael Nunez International Airport", City: "Cartagena", Country: "Colombia", Latitude: 10.4424, Longitude: -75.5130, Timezone: "America/Bogota"},
+	"CTS": {IATA: "CTS", ICAO: "RJCC", Name: "New Chitose Airport", City: "Sapporo", Country: "Japan", Latitude: 42.7752, Longitude: 141.692, Timezone: "Asia/Tokyo"},
+	"CTU": {IATA: "CTU", ICAO: "ZUUU", Name: "Chengdu Shuangliu International Airport", City: "Chengdu", Country: "China", Latitude: 30.5785, Longitude: 103.947, Timezone: "Asia/Shanghai"},
+	"CUN": {IATA: "CUN", ICAO: "MMUN", Name: "Cancun International Airport", City: "Cancun", Country: "Mexico", Latitude: 21.0365, Longitude: -86.8771, Timezone: "America/Cancun"},
+	"CUR": {IATA: "CUR", ICAO: "TNCC", Name: "Hato International Airport", City: "Willemstad", Country: "Netherlands Antilles", Latitude: 12.1889, Longitude: -68.9598, Timezone: "America/Curacao"},
+	"CUZ": {IATA: "CUZ", ICAO: "SPZO", Name: "Alejandro Velasco Astete International Airport", City: "Cuzco", Country: "Peru", Latitude: -13.5357, Longitude: -71.9388, Timezone: "America/Lima"},
+	"CVG": {IATA: "CVG", ICAO: "KCVG", Name: "Cincinnati Northern Kentucky International Airport", City: "Cincinnati", Country: "United States", Latitude: 39.0488, Longitude: -84.6678, Timezone: "America/New_York"},
+	"DAC": {IATA: "DAC", ICAO: "VGHS", Name: "Hazrat Shahjalal International Airport", City: "Dhaka", Country: "Bangladesh", Latitude: 23.8433, Longitude: 90.3978, Timezone: "Asia/Dhaka"},
+	"DAD": {IATA: "DAD", ICAO: "VVDN", Name: "Da Nang International Airport", City: "Danang", Country: "Vietnam", Latitude: 16.0439, Longitude: 108.199, Timezone: "Asia/Ho_Chi_Minh"},
+	"DAL": {IATA: "DAL", ICAO: "KDAL", Name: "Dallas Love Field", City: "Dallas", Country: "United States", Latitude: 32.8471, Longitude: -96.8518, Timezone: "America/Chicago"},
+	"DAR": {IATA: "DAR", ICAO: "HTDA", Name: "Julius Nyerere International Airport", City: "Dar Es Salaam", Country: "Tanzania", Latitude: -6.87811, Longitude: 39.2026, Timezone: "Africa/Dar_es_Salaam"},
+	"DBV": {IATA: "DBV", ICAO: "LDDU", Name: "Dubrovnik Airport", City: "Dubrovnik", Country: "Croatia", Latitude: 42.5614, Longitude: 18.2682, Timezone: "Europe/Zagreb"},
+	"DCA": {IATA: "DCA", ICAO: "KDCA", Name: "Ronald Reagan Washington National Airport", City: "Washington", Country: "United States", Latitude: 38.8521, Longitude: -77.0377, Timezone: "America/New_York"},
+	"DEL": {IATA: "DEL", ICAO: "VIDP", Name: "Indira Gandhi International Airport", City: "Delhi", Country: "India", Latitude: 28.5665, Longitude: 77.1031, Timezone: "Asia/Kolkata"},
+	"DEN": {IATA: "DEN", ICAO: "KDEN", Name: "Denver International Airport", City: "Denver", Country: "United States", Latitude: 39.8617, Longitude: -104.6731, Timezone: "America/Denver"},
+	"DFW": {IATA: "DFW", ICAO: "KDFW", Name: "Dallas Fort Worth International Airport", City: "Dallas-Fort Worth", Country: "United States", Latitude: 32.8968, Longitude: -97.0380, Timezone: "America/Chicago"},
+	"DME": {IATA: "DME", ICAO: "UUDD", Name: "Domodedovo International Airport", City: "Moscow", Country: "Russia", Latitude: 55.4088, Longitude: 37.9063, Timezone: "Europe/Moscow"},
+	"DMK": {IATA: "DMK", ICAO: "VTBD", Name: "Don Mueang International Airport", City: "Bangkok", Country: "Thailand", Latitude: 13.9126, Longitude: 100.607, Timezone: "Asia/Bangkok"},
+	"DMM": {IATA: "DMM", ICAO: "OEDF", Name: "King Fahd International Airport", City: "Dammam", Country: "Saudi Arabia", Latitude: 26.4712, Longitude: 49.7979, Timezone: "Asia/Riyadh"},
+	"DOH": {IATA: "DOH", ICAO: "OTHH", Name: "Hamad International Airport", City: "Doha", Country: "Qatar", Latitude: 25.2731, Longitude: 51.6081, Timezone: "Asia/Qatar"},
+	"DPS": {IATA: "DPS", ICAO: "WADD", Name: "Ngurah Rai (Bali) International Airport", City: "Denpasar", Country: "Indonesia", Latitude: -8.74817, Longitude: 115.167, Timezone: "Asia/Makassar"},
+	"DRW": {IATA: "DRW", ICAO: "YPDN", Name: "Darwin International Airport", City: "Darwin", Country: "Australia", Latitude: -12.4147, Longitude: 130.877, Timezone: "Australia/Darwin"},
+	"DSM": {IATA: "DSM", ICAO: "KDSM", Name: "Des Moines International Airport", City: "Des Moines", Country: "United States", Latitude: 41.5340, Longitude: -93.6631, Timezone: "America/Chicago"},
+	"DSS": {IATA: "DSS", ICAO: "GOBD", Name: "Blaise Diagne International Airport", City: "Dakar", Country: "Senegal", Latitude: 14.6700, Longitude: -17.0733, Timezone: "Africa/Dakar"},
+	"DTW": {IATA: "DTW", ICAO: "KDTW", Name: "Detroit Metropolitan Wayne County Airport", City: "Detroit", Country: "United States", Latitude: 42.2124, Longitude: -83.3534, Timezone: "America/Detroit"},
+	"DUB": {IATA: "DUB", ICAO: "EIDW", Name: "Dublin Airport", City: "Dublin", Country: "Ireland", Latitude: 53.4213, Longitude: -6.27007, Timezone: "Europe/Dublin"},
+	"DUR": {IATA: "DUR", ICAO: "FALE", Name: "King Shaka International Airport", City: "Durban", Country: "South Africa", Latitude: -29.6144, Longitude: 31.1197, Timezone: "Africa/Johannesburg"},
+	"DUS": {IATA: "DUS", ICAO: "EDDL", Name: "Dusseldorf International Airport", City: "Duesseldorf", Country: "Germany", Latitude: 51.2895, Longitude: 6.76678, Timezone: "Europe/Berlin"},
+	"DWC": {IATA: "DWC", ICAO: "OMDW", Name: "Al Maktoum International Airport", City: "Dubai", Country: "United Arab Emirates", Latitude: 24.8964, Longitude: 55.1614, Timezone: "Asia/Dubai"},
+	"DXB": {IATA: "DXB", ICAO: "OMDB", Name: "Dubai International Airport", City: "Dubai", Country: "United Arab Emirates", Latitude: 25.2528, Longitude: 55.3644, Timezone: "Asia/Dubai"},
+	"EBB": {IATA: "EBB", ICAO: "HUEN", Name: "Entebbe International Airport", City: "Entebbe", Country: "Uganda", Latitude: 0.0423860, Longitude: 32.4435, Timezone: "Africa/Kampala"},
+	"EDI": {IATA: "EDI", ICAO: "EGPH", Name: "Edinburgh Airport", City: "Edinburgh", Country: "United Kingdom", Latitude: 55.9500, Longitude: -3.37250, Timezone: "Europe/London"},
+	"EIN": {IATA: "EIN", ICAO: "EHEH", Name: "Eindhoven Airport", City: "Eindhoven", Country: "Netherlands", Latitude: 51.4501, Longitude: 5.37453, Timezone: "Europe/Amsterdam"},
+	"ELP": {IATA: "ELP", ICAO: "KELP", Name: "El Paso International Airport", City: "El Paso", Country: "United States", Latitude: 31.8072, Longitude: -106.3780, Timezone: "America/Denver"},
+	"ESB": {IATA: "ESB", ICAO: "LTAC", Name: "Esenboga International Airport", City: "Ankara", Country: "Turkey", Latitude: 40.1281, Longitude: 32.9951, Timezone: "Europe/Istanbul"},
+	"EVN": {IATA: "EVN", ICAO: "UDYZ", Name: "Zvartnots International Airport", City: "Yerevan", Country: "Armenia", Latitude: 40.1473, Longitude: 44.3959, Timezone: "Asia/Yerevan"},
+	"EWR": {IATA: "EWR", ICAO: "KEWR", Name: "Newark Liberty International Airport", City: "Newark", Country: "United States", Latitude: 40.6925, Longitude: -74.1687, Timezone: "America/New_York"},
+	"EZE": {IATA: "EZE", ICAO: "SAEZ", Name: "Ministro Pistarini International Airport", City: "Buenos Aires", Country: "Argentina", Latitude: -34.8222, Longitude: -58.5358, Timezone: "America/Argentina/Buenos_Aires"},
+	"FAI": {IATA: "FAI", ICAO: "PAFA", Name: "Fairbanks International Airport", City: "Fairbanks", Country: "United States", Latitude: 64.8151, Longitude: -147.8560, Timezone: "America/Anchorage"},
+	"FAO": {IATA: "FAO", ICAO: "LPFR", Name: "Faro Airport", City: "Faro", Country: "Portugal", Latitude: 37.0144, Longitude: -7.96591, Timezone: "Europe/Lisbon"},
+	"FCO": {IATA: "FCO", ICAO: "LIRF", Name: "Leonardo da Vinci-Fiumicino Airport", City: "Rome", Country: "Italy", Latitude: 41.8003, Longitude: 12.2389, Timezone: "Europe/Rome"},
+	"FLL": {IATA: "FLL", ICAO: "KFLL", Name: "Fort Lauderdale Hollywood International Airport", City: "Fort Lauderdale", Country: "United States", Latitude: 26.0726, Longitude: -80.1527, Timezone: "America/New_York"},
+	"FLR": {IATA: "FLR", ICAO: "LIRQ", Name: "Peretola Airport", City: "Florence", Country: "Italy", Latitude: 43.8100, Longitude: 11.2051, Timezone: "Europe/Rome"},
+	"FNC": {IATA: "FNC", ICAO: "LPMA", Name: "Madeira Airport", City: "Funchal", Country: "Portugal", Latitude: 32.6979, Longitude: -16.7745, Timezone: "Atlantic/Madeira"},
+	"FOR": {IATA: "FOR", ICAO: "SBFZ", Name: "Pinto Martins International Airport", City: "Fortaleza", Country: "Brazil", Latitude: -3.77628, Longitude: -38.5326, Timezone: "America/Fortaleza"},
+	"FRA": {IATA: "FRA", ICAO: "EDDF", Name: "Frankfurt am Main Airport", City: "Frankfurt", Country: "Germany", Latitude: 50.0333, Longitude: 8.57056, Timezone: "Europe/Berlin"},
+	"FUK": {IATA: "FUK", ICAO: "RJFF", Name: "Fukuoka Airport", City: "Fukuoka", Country: "Japan", Latitude: 33.5859, Longitude: 130.451, Timezone: "Asia/Tokyo"},
+	"GDL": {IATA: "GDL", ICAO: "MMGL", Name: "Don Miguel Hidalgo Y Costilla International Airport", City: "Guadalajara", Country: "Mexico", Latitude: 20.5218, Longitude: -103.3110, Timezone: "America/Mexico_City"},
+	"GDN": {IATA: "GDN", ICAO: "EPGD", Name: "Gdansk Lech Walesa Airport", City: "Gdansk", Country: "Poland", Latitude: 54.3776, Longitude: 18.4662, Timezone: "Europe/Warsaw"},
+	"GIG": {IATA: "GIG", ICAO: "SBGL", Name: "Rio Galeao - Tom Jobim International Airport", City: "Rio De Janeiro", Country: "Brazil", Latitude: -22.8100, Longitude: -43.2506, Timezone: "America/Sao_Paulo"},
+	"GLA": {IATA: "GLA", ICAO: "EGPF", Name: "Glasgow International Airport", City: "Glasgow", Country: "United Kingdom", Latitude: 55.8719, Longitude: -4.43306, Timezone: "Europe/London"},
+	"GMP": {IATA: "GMP", ICAO: "RKSS", Name: "Gimpo International Airport", City: "Seoul", Country: "South Korea", Latitude: 37.5583, Longitude: 126.791, Timezone: "Asia/Seoul"},
+	"GOI": {IATA: "GOI", ICAO: "VOGO", Name: "Dabolim Airport", City: "Goa", Country: "India", Latitude: 15.3808, Longitude: 73.8314, Timezone: "Asia/Kolkata"},
+	"GOT": {IATA: "GOT", ICAO: "ESGG", Name: "Gothenburg-Landvetter Airport", City: "Gothenborg", Country: "Sweden", Latitude: 57.6628, Longitude: 12.2798, Timezone: "Europe/Stockholm"},
+	"GRU": {IATA: "GRU", ICAO: "SBGR", Name: "Guarulhos - Governador Andre Franco Montoro International Airport", City: "Sao Paulo", Country: "Brazil", Latitude: -23.4356, Longitude: -46.4731, Timezone: "America/Sao_Paulo"},
+	"GSP": {IATA: "GSP", ICAO: "KGSP", Name: "Greenville Spartanburg International Airport", City: "Greenville", Country: "United States", Latitude: 34.8957, Longitude: -82.2189, Timezone: "America/New_York"},
+	"GUA": {IATA: "GUA", ICAO: "MGGT", Name: "La Aurora Airport", City: "Guatemala City", Country: "Guatemala", Latitude: 14.5833, Longitude: -90.5275, Timezone: "America/Guatemala"},
+	"GUM": {IATA: "GUM", ICAO: "PGUM", Name: "Antonio B. Won Pat International Airport", City: "Agana", Country: "Guam", Latitude: 13.4834, Longitude: 144.796, Timezone: "Pacific/Guam"},
+	"GVA": {IATA: "GVA", ICAO: "LSGG", Name: "Geneva Cointrin International Airport", City: "Geneva", Country: "Switzerland", Latitude: 46.2381, Longitude: 6.10895, Timezone: "Europe/Zurich"},
+	"GYD": {IATA: "GYD", ICAO: "UBBB", Name: "Heydar Aliyev International Airport", City: "Baku", Country: "Azerbaijan", Latitude: 40.4675, Longitude: 50.0467, Timezone: "Asia/Baku"},
+	"GYE": {IATA: "GYE", ICAO: "SEGU", Name: "Jose Joaquin de Olmedo International Airport", City: "Guayaquil", Country: "Ecuador", Latitude: -2.15742, Longitude: -79.8836, Timezone: "America/Guayaquil"},
+	"HAM": {IATA: "HAM", ICAO: "EDDH", Name: "Hamburg Airport", City: "Hamburg", Country: "Germany", Latitude: 53.6304, Longitude: 9.98823, Timezone: "Europe/Berlin"},
+	"HAN": {IATA: "HAN", ICAO: "VVNB", Name: "Noi Bai International Airport", City: "Hanoi", Country: "Vietnam", Latitude: 21.2212, Longitude: 105.807, Timezone: "Asia/Ho_Chi_Minh"},
+	"HAV": {IATA: "HAV", ICAO: "MUHA", Name: "Jose Marti International Airport", City: "Havana", Country: "Cuba", Latitude: 22.9892, Longitude: -82.4091, Timezone: "America/Havana"},
+	"HBA": {IATA: "HBA", ICAO: "YMHB", Name: "Hobart International Airport", City: "Hobart", Country: "Australia", Latitude: -42.8361, Longitude: 147.510, Timezone: "Australia/Hobart"},
+	"HEL": {IATA: "HEL", ICAO: "EFHK", Name: "Helsinki Vantaa Airport", City: "Helsinki", Country: "Finland", Latitude: 60.3172, Longitude: 24.9633, Timezone: "Europe/Helsinki"},
+	"HER": {IATA: "HER", ICAO: "LGIR", Name: "Heraklion International Nikos Kazantzakis Airport", City: "Heraklion", Country: "Greece", Latitude: 35.3397, Longitude: 25.1803, Timezone: "Europe/Athens"},
+	"HGH": {IATA: "HGH", ICAO: "ZSHC", Name: "Hangzhou Xiaoshan International Airport", City: "Hangzhou", Country: "China", Latitude: 30.2295, Longitude: 120.434, Timezone: "Asia/Shanghai"},
+	"HKG": {IATA: "HKG", ICAO: "VHHH", Name: "Hong Kong International Airport", City: "Hong Kong", Country: "Hong Kong", Latitude: 22.3089, Longitude: 113.915, Timezone: "Asia/Hong_Kong"},
+	"HKT": {IATA: "HKT", ICAO: "VTSP", Name: "Phuket International Airport", City: "Phuket", Country: "Thailand", Latitude: 8.11320, Longitude: 98.3169, Timezone: "Asia/Bangkok"},
+	"HND": {IATA: "HND", ICAO: "RJTT", Name: "Tokyo Haneda International Airport", City: "Tokyo", Country: "Japan", Latitude: 35.5523, Longitude: 139.780, Timezone: "Asia/Tokyo"},
+	"HNL": {IATA: "HNL", ICAO: "PHNL", Name: "Daniel K Inouye International Airport", City: "Honolulu", Country: "United States", Latitude: 21.3187, Longitude: -157.9220, Timezone: "Pacific/Honolulu"},
+	"HOU": {IATA: "HOU", ICAO: "KHOU", Name: "William P Hobby Airport", City: "Houston", Country: "United States", Latitude: 29.6454, Longitude: -95.2789, Timezone: "America/Chicago"},
+	"HRG": {IATA: "HRG", ICAO: "HEGN", Name: "Hurghada International Airport", City: "Hurghada", Country: "Egypt", Latitude: 27.1783, Longitude: 33.7994, Timezone: "Africa/Cairo"},
+	"HYD": {IATA: "HYD", ICAO: "VOHS", Name: "Rajiv Gandhi International Airport", City: "Hyderabad", Country: "India", Latitude: 17.2313, Longitude: 78.4298, Timezone: "Asia/Kolkata"},
+	"IAD": {IATA: "IAD", ICAO: "KIAD", Name: "Washington Dulles International Airport", City: "Washington", Country: "United States", Latitude: 38.9445, Longitude: -77.4558, Timezone: "America/New_York"},
+	"IAH": {IATA: "IAH", ICAO: "KIAH", Name: "George Bush Intercontinental Houston Airport", City: "Houston", Country: "United States", Latitude: 29.9844, Longitude: -95.3414, Timezone: "America/Chicago"},
+	"IBZ": {IATA: "IBZ", ICAO: "LEIB", Name: "Ibiza Airport", City: "Ibiza", Country: "Spain", Latitude: 38.8729, Longitude: 1.37312, Timezone: "Europe/Madrid"},
+	"ICN": {IATA: "ICN", ICAO: "RKSI", Name: "Incheon International Airport", City: "Seoul", Country: "South Korea", Latitude: 37.4691, Longitude: 126.451, Timezone: "Asia/Seoul"},
+	"IKA": {IATA: "IKA", ICAO: "OIIE", Name: "Imam Khomeini International Airport", City: "Tehran", Country: "Iran", Latitude: 35.4161, Longitude: 51.1522, Timezone: "Asia/Tehran"},
+	"IND": {IATA: "IND", ICAO: "KIND", Name: "Indianapolis International Airport", City: "Indianapolis", Country: "United States", Latitude: 39.7173, Longitude: -86.2944, Timezone: "America/Indiana/Indianapolis"},
+	"ISB": {IATA: "ISB", ICAO: "OPIS", Name: "Islamabad International Airport", City: "Islamabad", Country: "Pakistan", Latitude: 33.5491, Longitude: 72.8257, Timezone: "Asia/Karachi"},
+	"IST": {IATA: "IST", ICAO: "LTFM", Name: "Istanbul Airport", City: "Istanbul", Country: "Turkey", Latitude: 41.2753, Longitude: 28.7519, Timezone: "Europe/Istanbul"},
+	"ITM": {IATA: "ITM", ICAO: "RJOO", Name: "Osaka International Airport", City: "Osaka", Country: "Japan", Latitude: 34.7855, Longitude: 135.438, Timezone: "Asia/Tokyo"},
+	"JAX": {IATA: "JAX", ICAO: "KJAX", Name: "Jacksonville International Airport", City: "Jacksonville", Country: "United States", Latitude: 30.4941, Longitude: -81.6879, Timezone: "America/New_York"},
+	"JED": {IATA: "JED", ICAO: "OEJN", Name: "King Abdulaziz International Airport", City: "Jeddah", Country: "Saudi Arabia", Latitude: 21.6796, Longitude: 39.1565, Timezone: "Asia/Riyadh"},
+	"JFK": {IATA: "JFK", ICAO: "KJFK", Name: "John F Kennedy International Airport", City: "New York", Country: "United States", Latitude: 40.6398, Longitude: -73.7789, Timezone: "America/New_York"},
+	"JMK": {IATA: "JMK", ICAO: "LGMK", Name: "Mikonos Airport", City: "Mykonos", Country: "Greece", Latitude: 37.4351, Longitude: 25.3481, Timezone: "Europe/Athens"},
+	"JNB": {IATA: "JNB", ICAO: "FAOR", Name: "OR Tambo International Airport", City: "Johannesburg", Country: "South Africa", Latitude: -26.1392, Longitude: 28.2460, Timezone: "Africa/Johannesburg"},
+	"JNU": {IATA: "JNU", ICAO: "PAJN", Name: "Juneau International Airport", City: "Juneau", Country: "United States", Latitude: 58.3550, Longitude: -134.5760, Timezone: "America/Anchorage"},
+	"JTR": {IATA: "JTR", ICAO: "LGSR", Name: "Santorini Airport", City: "Thira", Country: "Greece", Latitude: 36.3992, Longitude: 25.4793, Timezone: "Europe/Athens"},
+	"KBP": {IATA: "KBP", ICAO: "UKBB", Name: "Boryspil International Airport", City: "Kiev", Country: "Ukraine", Latitude: 50.3450, Longitude: 30.8947, Timezone: "Europe/Kiev"},
+	"KEF": {IATA: "KEF", ICAO: "BIKF", Name: "Keflavik International Airport", City: "Keflavik", Country: "Iceland", Latitude: 63.9850, Longitude: -22.6056, Timezone: "Atlantic/Reykjavik"},
+	"KGL": {IATA: "KGL", ICAO: "HRYR", Name: "Kigali International Airport", City: "Kigali", Country: "Rwanda", Latitude: -1.96863, Longitude: 30.1395, Timezone: "Africa/Kigali"},
+	"KHH": {IATA: "KHH", ICAO: "RCKH", Name: "Kaohsiung International Airport", City: "Kaohsiung", Country: "Taiwan", Latitude: 22.5771, Longitude: 120.350, Timezone: "Asia/Taipei"},
+	"KHI": {IATA: "KHI", ICAO: "OPKC", Name: "Jinnah International Airport", City: "Karachi", Country: "Pakistan", Latitude: 24.9065, Longitude: 67.1608, Timezone: "Asia/Karachi"},
+	"KIN": {IATA: "KIN", ICAO: "MKJP", Name: "Norman Manley International Airport", City: "Kingston", Country: "Jamaica", Latitude: 17.9357, Longitude: -76.7875, Timezone: "America/Jamaica"},
+	"KIX": {IATA: "KIX", ICAO: "RJBB", Name: "Kansai International Airport", City: "Osaka", Country: "Japan", Latitude: 34.4273, Longitude: 135.244, Timezone: "Asia/Tokyo"},
+	"KMG": {IATA: "KMG", ICAO: "ZPPP", Name: "Kunming Changshui International Airport", City: "Kunming", Country: "China", Latitude: 25.1019, Longitude: 102.929, Timezone: "Asia/Shanghai"},
+	"KOA": {IATA: "KOA", ICAO: "PHKO", Name: "Ellison Onizuka Kona International At Keahole Airport", City: "Kona", Country: "United States", Latitude: 19.7388, Longitude: -156.0460, Timezone: "Pacific/Honolulu"},
+	"KRK": {IATA: "KRK", ICAO: "EPKK", Name: "John Paul II International Airport Krakow-Balice Airport", City: "Krakow", Country: "Poland", Latitude: 50.0777, Longitude: 19.7848, Timezone: "Europe/Warsaw"},
+	"KTM": {IATA: "KTM", ICAO: "VNKT", Name: "Tribhuvan International Airport", City: "Kathmandu", Country: "Nepal", Latitude: 27.6966, Longitude: 85.3591, Timezone: "Asia/Kathmandu"},
+	"KUL": {IATA: "KUL", ICAO: "WMKK", Name: "Kuala Lumpur International Airport", City: "Kuala Lumpur", Country: "Malaysia", Latitude: 2.74558, Longitude: 101.710, Timezone: "Asia/Kuala_Lumpur"},
+	"KWI": {IATA: "KWI", ICAO: "OKKK", Name: "Kuwait International Airport", City: "Kuwait", Country: "Kuwait", Latitude: 29.2266, Longitude: 47.9689, Timezone: "Asia/Kuwait"},
+	"LAD": {IATA: "LAD", ICAO: "FNLU", Name: "Quatro de Fevereiro Airport", City: "Luanda", Country: "Angola", Latitude: -8.85837, Longitude: 13.2312, Timezone: "Africa/Luanda"},
+	"LAS": {IATA: "LAS", ICAO: "KLAS", Name: "Harry Reid International Airport", City: "Las Vegas", Country: "United States", Latitude: 36.0801, Longitude: -115.1520, Timezone: "America/Los_Angeles"},
+	"LAX": {IATA: "LAX", ICAO: "KLAX", Name: "Los Angeles International Airport", City: "Los Angeles", Country: "United States", Latitude: 33.9425, Longitude: -118.4081, Timezone: "America/Los_Angeles"},
+	"LCA": {IATA: "LCA", ICAO: "LCLK", Name: "Larnaca International Airport", City: "Larnaca", Country: "Cyprus", Latitude: 34.8751, Longitude: 33.6249, Timezone: "Asia/Nicosia"},
+	"LCY": {IATA: "LCY", ICAO: "EGLC", Name: "London City Airport", City: "London", Country: "United Kingdom", Latitude: 51.5053, Longitude: 0.055278, Timezone: "Europe/London"},
+	"LED": {IATA: "LED", ICAO: "ULLI", Name: "Pulkovo Airport", City: "St. Petersburg", Country: "Russia", Latitude: 59.8003, Longitude: 30.2625, Timezone: "Europe/Moscow"},
+	"LGA": {IATA: "LGA", ICAO: "KLGA", Name: "La Guardia Airport", City: "New York", Country: "United States", Latitude: 40.7772, Longitude: -73.8726, Timezone: "America/New_York"},
+	"LGB": {IATA: "LGB", ICAO: "KLGB", Name: "Long Beach Airport", City: "Long Beach", Country: "United States", Latitude: 33.8177, Longitude: -118.1520, Timezone: "America/Los_Angeles"},
+	"LGW": {IATA: "LGW", ICAO: "EGKK", Name: "London Gatwick Airport", City: "London", Country: "United Kingdom", Latitude: 51.1481, Longitude: -0.190278, Timezone: "Europe/London"},
+	"LHE": {IATA: "LHE", ICAO: "OPLA", Name: "Alama Iqbal International Airport", City: "Lahore", Country: "Pakistan", Latitude: 31.5216, Longitude: 74.4036, Timezone: "Asia/Karachi"},
+	"LHR": {IATA: "LHR", ICAO: "EGLL", Name: "London Heathrow Airport", City: "London", Country: "United Kingdom", Latitude: 51.4706, Longitude: -0.461941, Timezone: "Europe/London"},
+	"LIH": {IATA: "LIH", ICAO: "PHLI", Name: "Lihue Airport", City: "Lihue", Country: "United States", Latitude: 21.9760, Longitude: -159.3390, Timezone: "Pacific/Honolulu"},
+	"LIM": {IATA: "LIM", ICAO: "SPJC", Name: "Jorge Chavez International Airport", City: "Lima", Country: "Peru", Latitude: -12.0219, Longitude: -77.1143, Timezone: "America/Lima"},
+	"LIN": {IATA: "LIN", ICAO: "LIML", Name: "Milano Linate Airport", City: "Milan", Country: "Italy", Latitude: 45.4451, Longitude: 9.27674, Timezone: "Europe/Rome"},
+	"LIR": {IATA: "LIR", ICAO: "MRLB", Name: "Daniel Oduber Quiros International Airport", City: "Liberia", Country: "Costa Rica", Latitude: 10.5933, Longitude: -85.5444, Timezone: "America/Costa_Rica"},
+	"LIS": {IATA: "LIS", ICAO: "LPPT", Name: "Humberto Delgado Airport", City: "Lisbon", Country: "Portugal", Latitude: 38.7813, Longitude: -9.13592, Timezone: "Europe/Lisbon"},
+	"LJU": {IATA: "LJU", ICAO: "LJLJ", Name: "Ljubljana Joze Pucnik Airport", City: "Ljubljana", Country: "Slovenia", Latitude: 46.2237, Longitude: 14.4576, Timezone: "Europe/Ljubljana"},
+	"LOS": {IATA: "LOS", ICAO: "DNMM", Name: "Murtala Muhammed International Airport", City: "Lagos", Country: "Nigeria", Latitude: 6.57737, Longitude: 3.32116, Timezone: "Africa/Lagos"},
+	"LPA": {IATA: "LPA", ICAO: "GCLP", Name: "Gran Canaria Airport", City: "Gran Canaria", Country: "Spain", Latitude: 27.9319, Longitude: -15.3866, Timezone: "Atlantic/Canary"},
+	"LTN": {IATA: "LTN", ICAO: "EGGW", Name: "London Luton Airport", City: "London", Country: "United Kingdom", Latitude: 51.8747, Longitude: -0.368333, Timezone: "Europe/London"},
+	"LUX": {IATA: "LUX", ICAO: "ELLX", Name: "Luxembourg-Findel International Airport", City: "Luxemburg", Country: "Luxembourg", Latitude: 49.6233, Longitude: 6.20444, Timezone: "Europe/Luxembourg"},
+	"LYS": {IATA: "LYS", ICAO: "LFLL", Name: "Lyon Saint-Exupery Airport", City: "Lyon", Country: "France", Latitude: 45.7256, Longitude: 5.08111, Timezone: "Europe/Paris"},
+	"MAA": {IATA: "MAA", ICAO: "VOMM", Name: "Chennai International Airport", City: "Madras", Country: "India", Latitude: 12.9900, Longitude: 80.1693, Timezone: "Asia/Kolkata"},
+	"MAD": {IATA: "MAD", ICAO: "LEMD", Name: "Adolfo Suarez Madrid-Barajas Airport", City: "Madrid", Country: "Spain", Latitude: 40.4719, Longitude: -3.56264, Timezone: "Europe/Madrid"},
+	"MAN": {IATA: "MAN", ICAO: "EGCC", Name: "Manchester Airport", City: "Manchester", Country: "United Kingdom", Latitude: 53.3537, Longitude: -2.27495, Timezone: "Europe/London"},
+	"MBJ": {IATA: "MBJ", ICAO: "MKJS", Name: "Sangster International Airport", City: "Montego Bay", Country: "Jamaica", Latitude: 18.5037, Longitude: -77.9134, Timezone: "America/Jamaica"},
+	"MCI": {IATA: "MCI", ICAO: "KMCI", Name: "Kansas City International Airport", City: "Kansas City", Country: "United States", Latitude: 39.2976, Longitude: -94.7139, Timezone: "America/Chicago"},
+	"MCO": {IATA: "MCO", ICAO: "KMCO", Name: "Orlando International Airport", City: "Orlando", Country: "United States", Latitude: 28.4294, Longitude: -81.3090, Timezone: "America/New_York"},
+	"MCT": {IATA: "MCT", ICAO: "OOMS", Name: "Muscat International Airport", City: "Muscat", Country: "Oman", Latitude: 23.5933, Longitude: 58.2844, Timezone: "Asia/Muscat"},
+	"MDE": {IATA: "MDE", ICAO: "SKRG", Name: "Jose Maria Cordova International Airport", City: "Rionegro", Country: "Colombia", Latitude: 6.16454, Longitude: -75.4231, Timezone: "America/Bogota"},
+	"MDW": {IATA: "MDW", ICAO: "KMDW", Name: "Chicago Midway International Airport", City: "Chicago", Country: "United States", Latitude: 41.7860, Longitude: -87.7524, Timezone: "America/Chicago"},
+	"MED": {IATA: "MED", ICAO: "OEMA", Name: "Prince Mohammad Bin Abdulaziz Airport", City: "Madinah", Country: "Saudi Arabia", Latitude: 24.5534, Longitude: 39.7051, Timezone: "Asia/Riyadh"},
+	"MEL": {IATA: "MEL", ICAO: "YMML", Name: "Melbourne International Airport", City: "Melbourne", Country: "Australia", Latitude: -37.6733, Longitude: 144.843, Timezone: "Australia/Melbourne"},
+	"MEM": {IATA: "MEM", ICAO: "KMEM", Name: "Memphis International Airport", City: "Memphis", Country: "United States", Latitude: 35.0424, Longitude: -89.9767, Timezone: "America/Chicago"},
+	"MEX": {IATA: "MEX", ICAO: "MMMX", Name: "Licenciado Benito Juarez International Airport", City: "Mexico City", Country: "Mexico", Latitude: 19.4363, Longitude: -99.0721, Timezone: "America/Mexico_City"},
+	"MFM": {IATA: "MFM", ICAO: "VMMC", Name: "Macau International Airport", City: "Macau", Country: "Macau", Latitude: 22.1496, Longitude: 113.592, Timezone: "Asia/Macau"},
+	"MIA": {IATA: "MIA", ICAO: "KMIA", Name: "Miami International Airport", City: "Miami", Country: "United States", Latitude: 25.7932, Longitude: -80.2906, Timezone: "America/New_York"},
+	"MKE": {IATA: "MKE", ICAO: "KMKE", Name: "General Mitchell International Airport", City: "Milwaukee", Country: "United States", Latitude: 42.9472, Longitude: -87.8966, Timezone: "America/Chicago"},
+	"MLA": {IATA: "MLA", ICAO: "LMML", Name: "Malta International Airport", City: "Malta", Country: "Malta", Latitude: 35.8575, Longitude: 14.4775, Timezone: "Europe/Malta"},
+	"MLE": {IATA: "MLE", ICAO: "VRMM", Name: "Velana International Airport", City: "Male", Country: "Maldives", Latitude: 4.19183, Longitude: 73.5291, Timezone: "Indian/Maldives"},
+	"MNL": {IATA: "MNL", ICAO: "RPLL", Name: "Ninoy Aquino International Airport", City: "Manila", Country: "Philippines", Latitude: 14.5086, Longitude: 121.020, Timezone: "Asia/Manila"},
+	"MRS": {IATA: "MRS", ICAO: "LFML", Name: "Marseille Provence Airport", City: "Marseille", Country: "France", Latitude: 43.4393, Longitude: 5.22142, Timezone: "Europe/Paris"},
+	"MRU": {IATA: "MRU", ICAO: "FIMP", Name: "Sir Seewoosagur Ramgoolam International Airport", City: "Plaisance", Country: "Mauritius", Latitude: -20.4302, Longitude: 57.6836, Timezone: "Indian/Mauritius"},
+	"MSP": {IATA: "MSP", ICAO: "KMSP", Name: "Minneapolis-St Paul International/Wold-Chamberlain Airport", City: "Minneapolis", Country: "United States", Latitude: 44.8820, Longitude: -93.2218, Timezone: "America/Chicago"},
+	"MSY": {IATA: "MSY", ICAO: "KMSY", Name: "Louis Armstrong New Orleans International Airport", City: "New Orleans", Country: "United States", Latitude: 29.9934, Longitude: -90.2580, Timezone: "America/Chicago"},
+	"MTY": {IATA: "MTY", ICAO: "MMMY", Name: "General Mariano Escobedo International Airport", City: "Monterrey", Country: "Mexico", Latitude: 25.7785, Longitude: -100.1070, Timezone: "America/Monterrey"},
+	"MUC": {IATA: "MUC", ICAO: "EDDM", Name: "Munich Airport", City: "Munich", Country: "Germany", Latitude: 48.3538, Longitude: 11.7861, Timezone: "Europe/Berlin"},
+	"MVD": {IATA: "MVD", ICAO: "SUMU", Name: "Carrasco International Airport", City: "Montevideo", Country: "Uruguay", Latitude: -34.8384, Longitude: -56.0308, Timezone: "America/Montevideo"},
+	"MXP": {IATA: "MXP", ICAO: "LIMC", Name: "Malpensa International Airport", City: "Milan", Country: "Italy", Latitude: 45.6306, Longitude: 8.72811, Timezone: "Europe/Rome"},
+	"NAN": {IATA: "NAN", ICAO: "NFFN", Name: "Nadi International Airport", City: "Nandi", Country: "Fiji", Latitude: -17.7554, Longitude: 177.443, Timezone: "Pacific/Fiji"},
+	"NAP": {IATA: "NAP", ICAO: "LIRN", Name: "Naples International Airport", City: "Naples", Country: "Italy", Latitude: 40.8860, Longitude: 14.2908, Timezone: "Europe/Rome"},
+	"NAS": {IATA: "NAS", ICAO: "MYNN", Name: "Lynden Pindling International Airport", City: "Nassau", Country: "Bahamas", Latitude: 25.0390, Longitude: -77.4662, Timezone: "America/Nassau"},
+	"NBO": {IATA: "NBO", ICAO: "HKJK", Name: "Jomo Kenyatta International Airport", City: "Nairobi", Country: "Kenya", Latitude: -1.31924, Longitude: 36.9278, Timezone: "Africa/Nairobi"},
+	"NCE": {IATA: "NCE", ICAO: "LFMN", Name: "Nice-Cote d'Azur Airport", City: "Nice", Country: "France", Latitude: 43.6584, Longitude: 7.21587, Timezone: "Europe/Paris"},
+	"NCL": {IATA: "NCL", ICAO: "EGNT", Name: "Newcastle Airport", City: "Newcastle", Country: "United Kingdom", Latitude: 55.0375, Longitude: -1.69167, Timezone: "Europe/London"},
+	"NGO": {IATA: "NGO", ICAO: "RJGG", Name: "Chubu Centrair International Airport", City: "Nagoya", Country: "Japan", Latitude: 34.8584, Longitude: 136.805, Timezone: "Asia/Tokyo"},
+	"NKG": {IATA: "NKG", ICAO: "ZSNJ", Name: "Nanjing Lukou Airport", City: "Nanjing", Country: "China", Latitude: 31.7420, Longitude: 118.862, Timezone: "Asia/Shanghai"},
+	"NQZ": {IATA: "NQZ", ICAO: "UACC", Name: "Nursultan Nazarbayev International Airport", City: "Astana", Country: "Kazakhstan", Latitude: 51.0222, Longitude: 71.4669, Timezone: "Asia/Almaty"},
+	"NRT": {IATA: "NRT", ICAO: "RJAA", Name: "Narita International Airport", City: "Tokyo", Country: "Japan", Latitude: 35.7647, Longitude: 140.386, Timezone: "Asia/Tokyo"},
+	"OAK": {IATA: "OAK", ICAO: "KOAK", Name: "Metropolitan Oakland International Airport", City: "Oakland", Country: "United States", Latitude: 37.7213, Longitude: -122.2210, Timezone: "America/Los_Angeles"},
+	"OGG": {IATA: "OGG", ICAO: "PHOG", Name: "Kahului Airport", City: "Kahului", Country: "United States", Latitude: 20.8986, Longitude: -156.4300, Timezone: "Pacific/Honolulu"},
+	"OKA": {IATA: "OKA", ICAO: "ROAH", Name: "Naha Airport", City: "Okinawa", Country: "Japan", Latitude: 26.1958, Longitude: 127.646, Timezone: "Asia/Tokyo"},
+	"OKC": {IATA: "OKC", ICAO: "KOKC", Name: "Will Rogers World Airport", City: "Oklahoma City", Country: "United States", Latitude: 35.3931, Longitude: -97.6007, Timezone: "America/Chicago"},
+	"OMA": {IATA: "OMA", ICAO: "KOMA", Name: "Eppley Airfield", City: "Omaha", Country: "United States", Latitude: 41.3032, Longitude: -95.8941, Timezone: "America/Chicago"},
+	"ONT": {IATA: "ONT", ICAO: "KONT", Name: "Ontario International Airport", City: "Ontario", Country: "United States", Latitude: 34.0560, Longitude: -117.6010, Timezone: "America/Los_Angeles"},
+	"OOL": {IATA: "OOL", ICAO: "YBCG", Name: "Gold Coast Airport", City: "Coolangatta", Country: "Australia", Latitude: -28.1644, Longitude: 153.505, Timezone: "Australia/Brisbane"},
+	"OPO": {IATA: "OPO", ICAO: "LPPR", Name: "Francisco de Sa Carneiro Airport", City: "Porto", Country: "Portugal", Latitude: 41.2481, Longitude: -8.68139, Timezone: "Europe/Lisbon"},
+	"ORD": {IATA: "ORD", ICAO: "KORD", Name: "Chicago O'Hare International Airport", City: "Chicago", Country: "United States", Latitude: 41.9786, Longitude: -87.9048, Timezone: "America/Chicago"},
+	"ORF": {IATA: "ORF", ICAO: "KORF", Name: "Norfolk International Airport", City: "Norfolk", Country: "United States", Latitude: 36.8946, Longitude: -76.2012, Timezone: "America/New_York"},
+	"ORY": {IATA: "ORY", ICAO: "LFPO", Name: "Paris-Orly Airport", City: "Paris", Country: "France", Latitude: 48.7253, Longitude: 2.35944, Timezone: "Europe/Paris"},
+	"OSL": {IATA: "OSL", ICAO: "ENGM", Name: "Oslo Gardermoen Airport", City: "Oslo", Country: "Norway", Latitude: 60.1939, Longitude: 11.1004, Timezone: "Europe/Oslo"},
+	"OTP": {IATA: "OTP", ICAO: "LROP", Name: "Henri Coanda International Airport", City: "Bucharest", Country: "Romania", Latitude: 44.5711, Longitude: 26.0850, Timezone: "Europe/Bucharest"},
+	"PBI": {IATA: "PBI", ICAO: "KPBI", Name: "Palm Beach International Airport", City: "West Palm Beach", Country: "United States", Latitude: 26.6832, Longitude: -80.0956, Timezone: "America/New_York"},
+	"PDX": {IATA: "PDX", ICAO: "KPDX", Name: "Portland International Airport", City: "Portland", Country: "United States", Latitude: 45.5887, Longitude: -122.5980, Timezone: "America/Los_Angeles"},
+	"PEK": {IATA: "PEK", ICAO: "ZBAA", Name: "Beijing Capital International Airport", City: "Beijing", Country: "China", Latitude: 40.0801, Longitude: 116.585, Timezone: "Asia/Shanghai"},
+	"PEN": {IATA: "PEN", ICAO: "WMKP", Name: "Penang International Airport", City: "Penang", Country: "Malaysia", Latitude: 5.29714, Longitude: 100.277, Timezone: "Asia/Kuala_Lumpur"},
+	"PER": {IATA: "PER", ICAO: "YPPH", Name: "Perth International Airport", City: "Perth", Country: "Australia", Latitude: -31.9403, Longitude: 115.967, Timezone: "Australia/Perth"},
+	"PHL": {IATA: "PHL", ICAO: "KPHL", Name: "Philadelphia International Airport", City: "Philadelphia", Country: "United States", Latitude: 39.8719, Longitude: -75.2411, Timezone: "America/New_York"},
+	"PHX": {IATA: "PHX", ICAO: "KPHX", Name: "Phoenix Sky Harbor International Airport", City: "Phoenix", Country: "United States", Latitude: 33.4343, Longitude: -112.0120, Timezone: "America/Phoenix"},
+	"PIT": {IATA: "PIT", ICAO: "KPIT", Name: "Pittsburgh International Airport", City: "Pittsburgh", Country: "United States", Latitude: 40.4915, Longitude: -80.2329, Timezone: "America/New_York"},
+	"PKX": {IATA: "PKX", ICAO: "ZBAD", Name: "Beijing Daxing International Airport", City: "Beijing", Country: "China", Latitude: 39.5098, Longitude: 116.411, Timezone: "Asia/Shanghai"},
+	"PMI": {IATA: "PMI", ICAO: "LEPA", Name: "Palma De Mallorca Airport", City: "Palma de Mallorca", Country: "Spain", Latitude: 39.5517, Longitude: 2.73881, Timezone: "Europe/Madrid"},
+	"PMO": {IATA: "PMO", ICAO: "LICJ", Name: "Falcone-Borsellino Airport", City: "Palermo", Country: "Italy", Latitude: 38.1760, Longitude: 13.0910, Timezone: "Europe/Rome"},
+	"PNH": {IATA: "PNH", ICAO: "VDTI", Name: "Techo International Airport", City: "Phnom Penh", Country: "Cambodia", Latitude: 11.3572, Longitude: 104.913, Timezone: "Asia/Phnom_Penh"},
+	"POA": {IATA: "POA", ICAO: "SBPA", Name: "Salgado Filho Airport", City: "Porto Alegre", Country: "Brazil", Latitude: -29.9944, Longitude: -51.1714, Timezone: "America/Sao_Paulo"},
+	"POS": {IATA: "POS", ICAO: "TTPP", Name: "Piarco International Airport", City: "Port-of-spain", Country: "Trinidad and Tobago", Latitude: 10.5954, Longitude: -61.3372, Timezone: "America/Port_of_Spain"},
+	"PPT": {IATA: "PPT", ICAO: "NTAA", Name: "Faa'a International Airport", City: "Papeete", Country: "French Polynesia", Latitude: -17.5537, Longitude: -149.607, Timezone: "Pacific/Tahiti"},
+	"PRG": {IATA: "PRG", ICAO: "LKPR", Name: "Vaclav Havel Airport Prague", City: "Prague", Country: "Czech Republic", Latitude: 50.1008, Longitude: 14.2600, Timezone: "Europe/Prague"},
+	"PSA": {IATA: "PSA", ICAO: "LIRP", Name: "Pisa International Airport", City: "Pisa", Country: "Italy", Latitude: 43.6839, Longitude: 10.3927, Timezone: "Europe/Rome"},
+	"PTY": {IATA: "PTY", ICAO: "MPTO", Name: "Tocumen International Airport", City: "Panama City", Country: "Panama", Latitude: 9.07136, Longitude: -79.3835, Timezone: "America/Panama"},
+	"PUJ": {IATA: "PUJ", ICAO: "MDPC", Name: "Punta Cana International Airport", City: "Punta Cana", Country: "Dominican Republic", Latitude: 18.5674, Longitude: -68.3634, Timezone: "America/Santo_Domingo"},
+	"PUS": {IATA: "PUS", ICAO: "RKPK", Name: "Gimhae International Airport", City: "Busan", Country: "South Korea", Latitude: 35.1795, Longitude: 128.938, Timezone: "Asia/Seoul"},
+	"PVD": {IATA: "PVD", ICAO: "KPVD", Name: "Theodore Francis Green State Airport", City: "Providence", Country: "United States", Latitude: 41.7240, Longitude: -71.4282, Timezone: "America/New_York"},
+	"PVG": {IATA: "PVG", ICAO: "ZSPD", Name: "Shanghai Pudong International Airport", City: "Shanghai", Country: "China", Latitude: 31.1434, Longitude: 121.805, Timezone: "Asia/Shanghai"},
+	"PVR": {IATA: "PVR", ICAO: "MMPR", Name: "Licenciado Gustavo Diaz Ordaz International Airport", City: "Puerto Vallarta", Country: "Mexico", Latitude: 20.6801, Longitude: -105.2540, Timezone: "America/Mexico_City"},
+	"RAK": {IATA: "RAK", ICAO: "GMMX", Name: "Menara Airport", City: "Marrakech", Country: "Morocco", Latitude: 31.6069, Longitude: -8.03630, Timezone: "Africa/Casablanca"},
+	"RDU": {IATA: "RDU", ICAO: "KRDU", Name: "Raleigh Durham International Airport", City: "Raleigh-durham", Country: "United States", Latitude: 35.8776, Longitude: -78.7875, Timezone: "America/New_York"},
+	"REC": {IATA: "REC", ICAO: "SBRF", Name: "Guararapes - Gilberto Freyre International Airport", City: "Recife", Country: "Brazil", Latitude: -8.12649, Longitude: -34.9236, Timezone: "America/Recife"},
+	"RGN": {IATA: "RGN", ICAO: "VYYY", Name: "Yangon International Airport", City: "Yangon", Country: "Burma", Latitude: 16.9073, Longitude: 96.1332, Timezone: "Asia/Rangoon"},
+	"RHO": {IATA: "RHO", ICAO: "LGRP", Name: "Diagoras Airport", City: "Rhodos", Country: "Greece", Latitude: 36.4054, Longitude: 28.0862, Timezone: "Europe/Athens"},
+	"RIC": {IATA: "RIC", ICAO: "KRIC", Name: "Richmond International Airport", City: "Richmond", Country: "United States", Latitude: 37.5052, Longitude: -77.3197, Timezone: "America/New_York"},
+	"RIX": {IATA: "RIX", ICAO: "EVRA", Name: "Riga International Airport", City: "Riga", Country: "Latvia", Latitude: 56.9236, Longitude: 23.9711, Timezone: "Europe/Riga"},
+	"RNO": {IATA: "RNO", ICAO: "KRNO", Name: "Reno Tahoe International Airport", City: "Reno", Country: "United States", Latitude: 39.4991, Longitude: -119.7680, Timezone: "America/Los_Angeles"},
+	"ROC": {IATA: "ROC", ICAO: "KROC", Name: "Greater Rochester International Airport", City: "Rochester", Country: "United States", Latitude: 43.1189, Longitude: -77.6724, Timezone: "America/New_York"},
+	"RSW": {IATA: "RSW", ICAO: "KRSW", Name: "Southwest Florida International Airport", City: "Fort Myers", Country: "United States", Latitude: 26.5362, Longitude: -81.7552, Timezone: "America/New_York"},
+	"RUH": {IATA: "RUH", ICAO: "OERK", Name: "King Khaled International Airport", City: "Riyadh", Country: "Saudi Arabia", Latitude: 24.9576, Longitude: 46.6988, Timezone: "Asia/Riyadh"},
+	"SAL": {IATA: "SAL", ICAO: "MSLP", Name: "Monsenor Oscar Arnulfo Romero International Airport", City: "San Salvador", Country: "El Salvador", Latitude: 13.4409, Longitude: -89.0557, Timezone: "America/El_Salvador"},
+	"SAN": {IATA: "SAN", ICAO: "KSAN", Name: "San Diego International Airport", City: "San Diego", Country: "United States", Latitude: 32.7336, Longitude: -117.1900, Timezone: "America/Los_Angeles"},
+	"SAT": {IATA: "SAT", ICAO: "KSAT", Name: "San Antonio International Airport", City: "San Antonio", Country: "United States", Latitude: 29.5337, Longitude: -98.4698, Timezone: "America/Chicago"},
+	"SAV": {IATA: "SAV", ICAO: "KSAV", Name: "Savannah Hilton Head International Airport", City: "Savannah", Country: "United States", Latitude: 32.1276, Longitude: -81.2021, Timezone: "America/New_York"},
+	"SAW": {IATA: "SAW", ICAO: "LTFJ", Name: "Sabiha Gokcen International Airport", City: "Istanbul", Country: "Turkey", Latitude: 40.8986, Longitude: 29.3092, Timezone: "Europe/Istanbul"},
+	"SCL": {IATA: "SCL", ICAO: "SCEL", Name: "Comodoro Arturo Merino Benitez International Airport", City: "Santiago", Country: "Chile", Latitude: -33.3930, Longitude: -70.7858, Timezone: "America/Santiago"},
+	"SDF": {IATA: "SDF", ICAO: "KSDF", Name: "Louisville Muhammad Ali International Airport", City: "Louisville", Country: "United States", Latitude: 38.1744, Longitude: -85.7360, Timezone: "America/New_York"},
+	"SDQ": {IATA: "SDQ", ICAO: "MDSD", Name: "Las Americas International Airport", City: "Santo Domingo", Country: "Dominican Republic", Latitude: 18.4297, Longitude: -69.6689, Timezone: "America/Santo_Domingo"},
+	"SDU": {IATA: "SDU", ICAO: "SBRJ", Name: "Santos Dumont Airport", City: "Rio De Janeiro", Country: "Brazil", Latitude: -22.9105, Longitude: -43.1631, Timezone: "America/Sao_Paulo"},
+	"SEA": {IATA: "SEA", ICAO: "KSEA", Name: "Seattle Tacoma International Airport", City: "Seattle", Country: "United States", Latitude: 47.4490, Longitude: -122.3090, Timezone: "America/Los_Angeles"},
+	"SEZ": {IATA: "SEZ", ICAO: "FSIA", Name: "Seychelles International Airport", City: "Mahe", Country: "Seychelles", Latitude: -4.67434, Longitude: 55.5218, Timezone: "Indian/Mahe"},
+	"SFO": {IATA: "SFO", ICAO: "KSFO", Name: "San Francisco International Airport", City: "San Francisco", Country: "United States", Latitude: 37.6190, Longitude: -122.3750, Timezone: "America/Los_Angeles"},
+	"SGN": {IATA: "SGN", ICAO: "VVTS", Name: "Tan Son Nhat International Airport", City: "Ho Chi Minh City", Country: "Vietnam", Latitude: 10.8188, Longitude: 106.652, Timezone: "Asia/Ho_Chi_Minh"},
+	"SHA": {IATA: "SHA", ICAO: "ZSSS", Name: "Shanghai Hongqiao International Airport", City: "Shanghai", Country: "China", Latitude: 31.1979, Longitude: 121.336, Timezone: "Asia/Shanghai"},
+	"SHJ": {IATA: "SHJ", ICAO: "OMSJ", Name: "Sharjah International Airport", City: "Sharjah", Country: "United Arab Emirates", Latitude: 25.3286, Longitude: 55.5172, Timezone: "Asia/Dubai"},
+	"SIN": {IATA: "SIN", ICAO: "WSSS", Name: "Singapore Changi Airport", City: "Singapore", Country: "Singapore", Latitude: 1.35019, Longitude: 103.994, Timezone: "Asia/Singapore"},
+	"SJC": {IATA: "SJC", ICAO: "KSJC", Name: "Norman Y. Mineta San Jose International Airport", City: "San Jose", Country: "United States", Latitude: 37.3626, Longitude: -121.9290, Timezone: "America/Los_Angeles"},
+	"SJD": {IATA: "SJD", ICAO: "MMSD", Name: "Los Cabos International Airport", City: "San Jose Del Cabo", Country: "Mexico", Latitude: 23.1518, Longitude: -109.7210, Timezone: "America/Mazatlan"},
+	"SJO": {IATA: "SJO", ICAO: "MROC", Name: "Juan Santamaria International Airport", City: "San Jose", Country: "Costa Rica", Latitude: 9.99386, Longitude: -84.2088, Timezone: "America/Costa_Rica"},
+	"SJU": {IATA: "SJU", ICAO: "TJSJ", Name: "Luis Munoz Marin International Airport", City: "San Juan", Country: "Puerto Rico", Latitude: 18.4394, Longitude: -66.0018, Timezone: "America/Puerto_Rico"},
+	"SKG": {IATA: "SKG", ICAO: "LGTS", Name: "Thessaloniki Macedonia International Airport", City: "Thessaloniki", Country: "Greece", Latitude: 40.5197, Longitude: 22.9709, Timezone: "Europe/Athens"},
+	"SLC": {IATA: "SLC", ICAO: "KSLC", Name: "Salt Lake City International Airport", City: "Salt Lake City", Country: "United States", Latitude: 40.7884, Longitude: -111.9780, Timezone: "America/Denver"},
+	"SMF": {IATA: "SMF", ICAO: "KSMF", Name: "Sacramento International Airport", City: "Sacramento", Country: "United States", Latitude: 38.6954, Longitude: -121.5910, Timezone: "America/Los_Angeles"},
+	"SNA": {IATA: "SNA", ICAO: "KSNA", Name: "John Wayne Airport-Orange County Airport", City: "Santa Ana", Country: "United States", Latitude: 33.6757, Longitude: -117.8680, Timezone: "America/Los_Angeles"},
+	"SNN": {IATA: "SNN", ICAO: "EINN", Name: "Shannon Airport", City: "Shannon", Country: "Ireland", Latitude: 52.7020, Longitude: -8.92482, Timezone: "Europe/Dublin"},
+	"SOF": {IATA: "SOF", ICAO: "LBSF", Name: "Sofia Airport", City: "Sofia", Country: "Bulgaria", Latitude: 42.6967, Longitude: 23.4114, Timezone: "Europe/Sofia"},
+	"SPU": {IATA: "SPU", ICAO: "LDSP", Name: "Split Airport", City: "Split", Country: "Croatia", Latitude: 43.5389, Longitude: 16.2980, Timezone: "Europe/Zagreb"},
+	"SSA": {IATA: "SSA", ICAO: "SBSV", Name: "Deputado Luiz Eduardo Magalhaes International Airport", City: "Salvador", Country: "Brazil", Latitude: -12.9086, Longitude: -38.3225, Timezone: "America/Bahia"},
+	"SSH": {IATA: "SSH", ICAO: "HESH", Name: "Sharm El Sheikh International Airport", City: "Sharm El Sheikh", Country: "Egypt", Latitude: 27.9773, Longitude: 34.3950, Timezone: "Africa/Cairo"},
+	"STL": {IATA: "STL", ICAO: "KSTL", Name: "St Louis Lambert International Airport", City: "St. Louis", Country: "United States", Latitude: 38.7487, Longitude: -90.3700, Timezone: "America/Chicago"},
+	"STN": {IATA: "STN", ICAO: "EGSS", Name: "London Stansted Airport", City: "London", Country: "United Kingdom", Latitude: 51.8850, Longitude: 0.235000, Timezone: "Europe/London"},
+	"STR": {IATA: "STR", ICAO: "EDDS", Name: "Stuttgart Airport", City: "Stuttgart", Country: "Germany", Latitude: 48.6899, Longitude: 9.22196, Timezone: "Europe/Berlin"},
+	"SUB": {IATA: "SUB", ICAO: "WARR", Name: "Juanda International Airport", City: "Surabaya", Country: "Indonesia", Latitude: -7.37983, Longitude: 112.787, Timezone: "Asia/Jakarta"},
+	"SVO": {IATA: "SVO", ICAO: "UUEE", Name: "Sheremetyevo International Airport", City: "Moscow", Country: "Russia", Latitude: 55.9726, Longitude: 37.4146, Timezone: "Europe/Moscow"},
+	"SVQ": {IATA: "SVQ", ICAO: "LEZL", Name: "Sevilla Airport", City: "Sevilla", Country: "Spain", Latitude: 37.4180, Longitude: -5.89311, Timezone: "Europe/Madrid"},
+	"SXM": {IATA: "SXM", ICAO: "TNCM", Name: "Princess Juliana International Airport", City: "Philipsburg", Country: "Netherlands Antilles", Latitude: 18.0410, Longitude: -63.1089, Timezone: "America/Lower_Princes"},
+	"SYD": {IATA: "SYD", ICAO: "YSSY", Name: "Sydney Kingsford Smith International Airport", City: "Sydney", Country: "Australia", Latitude: -33.9461, Longitude: 151.177, Timezone: "Australia/Sydney"},
+	"SYR": {IATA: "SYR", ICAO: "KSYR", Name: "Syracuse Hancock International Airport", City: "Syracuse", Country: "United States", Latitude: 43.1112, Longitude: -76.1063, Timezone: "America/New_York"},
+	"SZG": {IATA: "SZG", ICAO: "LOWS", Name: "Salzburg Airport", City: "Salzburg", Country: "Austria", Latitude: 47.7933, Longitude: 13.0043, Timezone: "Europe/Vienna"},
+	"SZX": {IATA: "SZX", ICAO: "ZGSZ", Name: "Shenzhen Bao'an International Airport", City: "Shenzhen", Country: "China", Latitude: 22.6393, Longitude: 113.811, Timezone: "Asia/Shanghai"},
+	"TAS": {IATA: "TAS", ICAO: "UTTT", Name: "Tashkent International Airport", City: "Tashkent", Country: "Uzbekistan", Latitude: 41.2579, Longitude: 69.2812, Timezone: "Asia/Tashkent"},
+	"TBS": {IATA: "TBS", ICAO: "UGTB", Name: "Tbilisi International Airport", City: "Tbilisi", Country: "Georgia", Latitude: 41.6692, Longitude: 44.9547, Timezone: "Asia/Tbilisi"},
+	"TFS": {IATA: "TFS", ICAO: "GCTS", Name: "Tenerife South Airport", City: "Tenerife", Country: "Spain", Latitude: 28.0445, Longitude: -16.5725, Timezone: "Atlantic/Canary"},
+	"TFU": {IATA: "TFU", ICAO: "ZUTF", Name: "Chengdu Tianfu International Airport", City: "Chengdu", Country: "China", Latitude: 30.3125, Longitude: 104.444, Timezone: "Asia/Shanghai"},
+	"TIJ": {IATA: "TIJ", ICAO: "MMTJ", Name: "General Abelardo L. Rodriguez International Airport", City: "Tijuana", Country: "Mexico", Latitude: 32.5411, Longitude: -116.9700, Timezone: "America/Tijuana"},
+	"TLL": {IATA: "TLL", ICAO: "EETN", Name: "Lennart Meri Tallinn Airport", City: "Tallinn", Country: "Estonia", Latitude: 59.4133, Longitude: 24.8328, Timezone: "Europe/Tallinn"},
+	"TLS": {IATA: "TLS", ICAO: "LFBO", Name: "Toulouse-Blagnac Airport", City: "Toulouse", Country: "France", Latitude: 43.6291, Longitude: 1.36382, Timezone: "Europe/Paris"},
+	"TLV": {IATA: "TLV", ICAO: "LLBG", Name: "Ben Gurion International Airport", City: "Tel-aviv", Country: "Israel", Latitude: 32.0114, Longitude: 34.8867, Timezone: "Asia/Jerusalem"},
+	"TPA": {IATA: "TPA", ICAO: "KTPA", Name: "Tampa International Airport", City: "Tampa", Country: "United States", Latitude: 27.9755, Longitude: -82.5332, Timezone: "America/New_York"},
+	"TPE": {IATA: "TPE", ICAO: "RCTP", Name: "Taiwan Taoyuan International Airport", City: "Taipei", Country: "Taiwan", Latitude: 25.0777, Longitude: 121.233, Timezone: "Asia/Taipei"},
+	"TRD": {IATA: "TRD", ICAO: "ENVA", Name: "Trondheim Airport Vaernes", City: "Trondheim", Country: "Norway", Latitude: 63.4578, Longitude: 10.9240, Timezone: "Europe/Oslo"},
+	"TSA": {IATA: "TSA", ICAO: "RCSS", Name: "Taipei Songshan Airport", City: "Taipei", Country: "Taiwan", Latitude: 25.0694, Longitude: 121.552, Timezone: "Asia/Taipei"},
+	"TUL": {IATA: "TUL", ICAO: "KTUL", Name: "Tulsa International Airport", City: "Tulsa", Country: "United States", Latitude: 36.1984, Longitude: -95.8881, Timezone: "America/Chicago"},
+	"TUN": {IATA: "TUN", ICAO: "DTTA", Name: "Tunis Carthage International Airport", City: "Tunis", Country: "Tunisia", Latitude: 36.8510, Longitude: 10.2272, Timezone: "Africa/Tunis"},
+	"TUS": {IATA: "TUS", ICAO: "KTUS", Name: "Tucson International Airport", City: "Tucson", Country: "United States", Latitude: 32.1161, Longitude: -110.9410, Timezone: "America/Phoenix"},
+	"UIO": {IATA: "UIO", ICAO: "SEQM", Name: "Mariscal Sucre International Airport", City: "Quito", Country: "Ecuador", Latitude: -0.129167, Longitude: -78.3575, Timezone: "America/Guayaquil"},
+	"VCE": {IATA: "VCE", ICAO: "LIPZ", Name: "Venice Marco Polo Airport", City: "Venice", Country: "Italy", Latitude: 45.5053, Longitude: 12.3519, Timezone: "Europe/Rome"},
+	"VCP": {IATA: "VCP", ICAO: "SBKP", Name: "Viracopos International Airport", City: "Campinas", Country: "Brazil", Latitude: -23.0074, Longitude: -47.1345, Timezone: "America/Sao_Paulo"},
+	"VIE": {IATA: "VIE", ICAO: "LOWW", Name: "Vienna International Airport", City: "Vienna", Country: "Austria", Latitude: 48.1103, Longitude: 16.5697, Timezone: "Europe/Vienna"},
+	"VKO": {IATA: "VKO", ICAO: "UUWW", Name: "Vnukovo International Airport", City: "Moscow", Country: "Russia", Latitude: 55.5915, Longitude: 37.2615, Timezone: "Europe/Moscow"},
+	"VLC": {IATA: "VLC", ICAO: "LEVC", Name: "Valencia Airport", City: "Valencia", Country: "Spain", Latitude: 39.4893, Longitude: -0.481625, Timezone: "Europe/Madrid"},
+	"VNO": {IATA: "VNO", ICAO: "EYVI", Name: "Vilnius International Airport", City: "Vilnius", Country: "Lithuania", Latitude: 54.6341, Longitude: 25.2858, Timezone: "Europe/Vilnius"},
+	"VVI": {IATA: "VVI", ICAO: "SLVR", Name: "Viru Viru International Airport", City: "Santa Cruz", Country: "Bolivia", Latitude: -17.6448, Longitude: -63.1354, Timezone: "America/La_Paz"},
+	"WAW": {IATA: "WAW", ICAO: "EPWA", Name: "Warsaw Chopin Airport", City: "Warsaw", Country: "Poland", Latitude: 52.1657, Longitude: 20.9671, Timezone: "Europe/Warsaw"},
+	"WLG": {IATA: "WLG", ICAO: "NZWN", Name: "Wellington International Airport", City: "Wellington", Country: "New Zealand", Latitude: -41.3272, Longitude: 174.805, Timezone: "Pacific/Auckland"},
+	"WUH": {IATA: "WUH", ICAO: "ZHHH", Name: "Wuhan Tianhe International Airport", City: "Wuhan", Country: "China", Latitude: 30.7838, Longitude: 114.208, Timezone: "Asia/Shanghai"},
+	"XIY": {IATA: "XIY", ICAO: "ZLXY", Name: "Xi'an Xianyang International Airport", City: "Xi'an", Country: "China", Latitude: 34.4471, Longitude: 108.752, Timezone: "Asia/Shanghai"},
+	"XMN": {IATA: "XMN", ICAO: "ZSAM", Name: "Xiamen Gaoqi International Airport", City: "Xiamen", Country: "China", Latitude: 24.5440, Longitude: 118.128, Timezone: "Asia/Shanghai"},
+	"YEG": {IATA: "YEG", ICAO: "CYEG", Name: "Edmonton International Airport", City: "Edmonton", Country: "Canada", Latitude: 53.3097, Longitude: -113.5800, Timezone: "America/Edmonton"},
+	"YHZ": {IATA: "YHZ", ICAO: "CYHZ", Name: "Halifax / Stanfield International Airport", City: "Halifax", Country: "Canada", Latitude: 44.8808, Longitude: -63.5086, Timezone: "America/Halifax"},
+	"YOW": {IATA: "YOW", ICAO: "CYOW", Name: "Ottawa Macdonald-Cartier International Airport", City: "Ottawa", Country: "Canada", Latitude: 45.3225, Longitude: -75.6692, Timezone: "America/Toronto"},
+	"YQB": {IATA: "YQB", ICAO: "CYQB", Name: "Quebec Jean Lesage International Airport", City: "Quebec", Country: "Canada", Latitude: 46.7911, Longitude: -71.3933, Timezone: "America/Toronto"},
+	"YTZ": {IATA: "YTZ", ICAO: "CYTZ", Name: "Billy Bishop Toronto City Centre Airport", City: "Toronto", Country: "Canada", Latitude: 43.6275, Longitude: -79.3962, Timezone: "America/Toronto"},
+	"YUL": {IATA: "YUL", ICAO: "CYUL", Name: "Montreal / Pierre Elliott Trudeau International Airport", City: "Montreal", Country: "Canada", Latitude: 45.4706, Longitude: -73.7408, Timezone: "America/Toronto"},
+	"YVR": {IATA: "YVR", ICAO: "CYVR", Name: "Vancouver International Airport", City: "Vancouver", Country: "Canada", Latitude: 49.1939, Longitude: -123.1840, Timezone: "America/Vancouver"},
+	"YWG": {IATA: "YWG", ICAO: "CYWG", Name: "Winnipeg / James Armstrong Richardson International Airport", City: "Winnipeg", Country: "Canada", Latitude: 49.9100, Longitude: -97.2399, Timezone: "America/Winnipeg"},
+	"YYC": {IATA: "YYC", ICAO: "CYYC", Name: "Calgary International Airport", City: "Calgary", Country: "Canada", Latitude: 51.1139, Longitude: -114.0200, Timezone: "America/Edmonton"},
+	"YYJ": {IATA: "YYJ", ICAO: "CYYJ", Name: "Victoria International Airport", City: "Victoria", Country: "Canada", Latitude: 48.6469, Longitude: -123.4260, Timezone: "America/Vancouver"},
+	"YYZ": {IATA: "YYZ", ICAO: "CYYZ", Name: "Lester B. Pearson International Airport", City: "Toronto", Country: "Canada", Latitude: 43.6772, Longitude: -79.6306, Timezone: "America/Toronto"},
+	"ZAG": {IATA: "ZAG", ICAO: "LDZA", Name: "Zagreb Airport", City: "Zagreb", Country: "Croatia", Latitude: 45.7429, Longitude: 16.0688, Timezone: "Europe/Zagreb"},
+	"ZNZ": {IATA: "ZNZ", ICAO: "HTZA", Name: "Abeid Amani Karume International Airport", City: "Zanzibar", Country: "Tanzania", Latitude: -6.22202, Longitude: 39.2249, Timezone: "Africa/Dar_es_Salaam"},
+	"ZQN": {IATA: "ZQN", ICAO: "NZQN", Name: "Queenstown International Airport", City: "Queenstown International", Country: "New Zealand", Latitude: -45.0211, Longitude: 168.739, Timezone: "Pacific/Auckland"},
+	"ZRH": {IATA: "ZRH", ICAO: "LSZH", Name: "Zurich Airport", City: "Zurich", Country: "Switzerland", Latitude: 47.4647, Longitude: 8.54917, Timezone: "Europe/Zurich"},
+}
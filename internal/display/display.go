@@ -12,7 +12,10 @@ import (
 
 	"github.com/briandowns/spinner"
 	"github.com/fatih/color"
+	"github.com/joshuachuah/flightcli/internal/aircraft"
+	"github.com/joshuachuah/flightcli/internal/airports"
 	"github.com/joshuachuah/flightcli/internal/models"
+	"github.com/joshuachuah/flightcli/internal/provider"
 	"github.com/joshuachuah/flightcli/internal/sanitize"
 )
 
@@ -52,11 +55,35 @@ func PrintFlightStatus(flight *models.Flight) {
 	labelStyle.Print("Flight:   ")
 	fmt.Println(flightNumber)
 
+	if len(flight.Codeshares) > 0 {
+		labelStyle.Print("Codeshare:")
+		fmt.Printf(" %s\n", codeshareList(flight.Codeshares))
+	}
+
+	if flight.FlightDate != "" {
+		labelStyle.Print("Date:     ")
+		fmt.Println(FlightDateText(flight.FlightDate))
+	}
+
 	labelStyle.Print("Airline:  ")
 	fmt.Println(airline)
 
+	if aircraft := AircraftText(flight.Aircraft); aircraft != "" {
+		labelStyle.Print("Aircraft: ")
+		fmt.Println(aircraft)
+	}
+
 	labelStyle.Print("Route:    ")
-	fmt.Printf("%s -> %s\n", departure, arrival)
+	fmt.Println(routeText(departure, arrival))
+
+	if from := LegDetailsText(flight.DepartureDetails, flight.Departure); from != "" {
+		labelStyle.Print("From:     ")
+		fmt.Println(from)
+	}
+	if to := LegDetailsText(flight.ArrivalDetails, flight.Arrival); to != "" {
+		labelStyle.Print("To:       ")
+		fmt.Println(to)
+	}
 
 	labelStyle.Print("Status:   ")
 	StatusColor(status).Println(status)
@@ -64,11 +91,13 @@ func PrintFlightStatus(flight *models.Flight) {
 	if !flight.DepartureTime.IsZero() {
 		labelStyle.Print("Departure:")
 		fmt.Printf(" %s\n", formatFlightTimestamp(flight.DepartureTime))
+		printTimeBreakdown(flight.DepartureDetails)
 	}
 
 	if !flight.ArrivalTime.IsZero() {
 		labelStyle.Print("Arrival:  ")
 		fmt.Printf(" %s\n", formatFlightTimestamp(flight.ArrivalTime))
+		printTimeBreakdown(flight.ArrivalDetails)
 	}
 
 	totalDuration, elapsed, remaining, hasTotal, hasElapsed, hasRemaining := flightTimingMetrics(flight.DepartureTime, flight.ArrivalTime, time.Now())
@@ -92,17 +121,34 @@ func PrintFlightStatus(flight *models.Flight) {
 		fmt.Printf("%.0f ft\n", flight.Altitude)
 		labelStyle.Print("Speed:    ")
 		fmt.Printf("%.0f mph\n", flight.Speed)
+		if motion := MotionText(flight); motion != "" {
+			labelStyle.Print("Motion:   ")
+			fmt.Println(motion)
+		} else if flight.Heading != 0 {
+			labelStyle.Print("Heading:  ")
+			fmt.Printf("%.0f°\n", flight.Heading)
+		}
+	}
+
+	if !flight.LiveUpdated.IsZero() {
+		now := time.Now()
+		labelStyle.Print("Updated:  ")
+		if flight.LiveStale(now) {
+			yellowStyle.Println(LiveUpdatedText(flight, now))
+		} else {
+			fmt.Println(LiveUpdatedText(flight, now))
+		}
 	}
 }
 
 // PrintAirportFlights renders the airport flight table with colored status.
 func PrintAirportFlights(flights []models.AirportFlight, airportCode string, flightType string) {
-	printAirportFlightTable(flights, airportBoardTitle(airportCode, flightType))
+	printAirportFlightTable(flights, airportBoardTitle(airportCode, flightType), isArrivals(flightType))
 }
 
 // PrintSearchResults renders a route search result table.
 func PrintSearchResults(flights []models.AirportFlight, from, to string) {
-	labelStyle.Printf("Flights from %s to %s:\n\n", sanitize.TerminalString(from), sanitize.TerminalString(to))
+	labelStyle.Printf("Flights from %s to %s:\n\n", AirportLabel(from), AirportLabel(to))
 	if len(flights) == 0 {
 		dimStyle.Println("  No flights found.")
 		return
@@ -116,9 +162,211 @@ func PrintSearchResults(flights []models.AirportFlight, from, to string) {
 	}
 }
 
-// PrintCachedIndicator prints a dim "(cached)" label on its own line.
-func PrintCachedIndicator() {
-	dimStyle.Println("(cached)")
+// PrintSchedule renders a timetable, noting who operates each codeshare.
+func PrintSchedule(flights []models.ScheduledFlight, from, to, date string) {
+	labelStyle.Printf("Scheduled flights from %s to %s on %s:\n\n",
+		AirportLabel(from), AirportLabel(to), FlightDateText(date))
+	if len(flights) == 0 {
+		dimStyle.Println("  No flights found.")
+		return
+	}
+
+	for _, f := range flights {
+		row := fmt.Sprintf("  %-10s %-25s %s",
+			sanitize.TerminalString(f.FlightNumber), sanitize.TerminalString(f.Airline), ScheduleTimes(f))
+		if operator := OperatedBy(f); operator != "" {
+			row = fmt.Sprintf("%-52s %s", row, dimStyle.Sprint(operator))
+		}
+		fmt.Println(row)
+	}
+}
+
+// ScheduleTimes formats a timetable entry's times as "22:15 -> 06:40+1".
+func ScheduleTimes(f models.ScheduledFlight) string {
+	times := sanitize.TerminalString(f.DepartureTime) + " -> " + sanitize.TerminalString(f.ArrivalTime)
+	if f.ArrivalDayOffset > 0 {
+		times += fmt.Sprintf("+%d", f.ArrivalDayOffset)
+	}
+	return times
+}
+
+// OperatedBy returns "operated by Delta Air Lines DL123" for a codeshare,
+// or "" when the marketing airline flies it.
+func OperatedBy(f models.ScheduledFlight) string {
+	if !f.Codeshare() {
+		return ""
+	}
+	return operatedBy(f.OperatingAirline, f.OperatingFlightNumber)
+}
+
+// BoardCodeshares notes how a board row relates to codeshares: "also
+// IB4218, BA1511" for an operating flight with codeshares folded in, or
+// "operated by American Airlines AA100" for a codeshare row.
+func BoardCodeshares(f models.AirportFlight) string {
+	if f.Codeshare() {
+		return operatedBy(f.OperatingAirline, f.OperatingFlightNumber)
+	}
+	if len(f.Codeshares) > 0 {
+		return "also " + codeshareList(f.Codeshares)
+	}
+	return ""
+}
+
+func operatedBy(airline, flightNumber string) string {
+	return "operated by " + strings.TrimSpace(sanitize.TerminalString(airline)+" "+sanitize.TerminalString(flightNumber))
+}
+
+func codeshareList(numbers []string) string {
+	sanitized := make([]string, len(numbers))
+	for i, number := range numbers {
+		sanitized[i] = sanitize.TerminalString(number)
+	}
+	return strings.Join(sanitized, ", ")
+}
+
+// LegDetailsText describes one end of a flight, e.g. "John F Kennedy
+// International · Terminal 4 · Gate B22", or returns "" when nothing is
+// known. The airport name comes from the airports table for code when the
+// provider did not report one.
+func LegDetailsText(d models.LegDetails, code string) string {
+	var parts []string
+	airport := sanitize.TerminalString(d.Airport)
+	if airport == "" {
+		airport = sanitize.TerminalString(airports.Name(code))
+	}
+	if airport != "" {
+		parts = append(parts, airport)
+	}
+	if terminal := sanitize.TerminalString(d.Terminal); terminal != "" {
+		parts = append(parts, "Terminal "+terminal)
+	}
+	if gate := sanitize.TerminalString(d.Gate); gate != "" {
+		parts = append(parts, "Gate "+gate)
+	}
+	if baggage := sanitize.TerminalString(d.Baggage); baggage != "" {
+		parts = append(parts, "Baggage "+baggage)
+	}
+	return strings.Join(parts, " · ")
+}
+
+// TimeBreakdown lists a leg's scheduled, estimated and actual times with
+// the computed delay, as "Scheduled 14:05 · Estimated 14:40 (+35m)". It
+// returns "" when only the scheduled time is known, since that adds
+// nothing to the summary time.
+func TimeBreakdown(d models.LegDetails) string {
+	var parts []string
+	if !d.Scheduled.IsZero() {
+		parts = append(parts, "Scheduled "+d.Scheduled.Format("15:04"))
+	}
+	if !d.Estimated.IsZero() {
+		parts = append(parts, "Estimated "+d.Estimated.Format("15:04"))
+	}
+	if !d.Actual.IsZero() {
+		parts = append(parts, "Actual "+d.Actual.Format("15:04"))
+	}
+
+	delay, ok := d.ComputedDelay()
+	text := strings.Join(parts, " · ")
+	switch {
+	case ok && DelayText(delay) != "" && text != "":
+		return text + " (" + DelayText(delay) + ")"
+	case ok && DelayText(delay) != "":
+		return "Delay " + DelayText(delay)
+	case len(parts) > 1:
+		return text
+	default:
+		return ""
+	}
+}
+
+// DelayText formats a delay as "+35m" or "+1h 5m", or an early running as
+// "-5m". It returns "" for anything under a minute either way.
+func DelayText(delay time.Duration) string {
+	switch {
+	case delay >= time.Minute:
+		return "+" + FormatDuration(delay)
+	case delay <= -time.Minute:
+		return "-" + FormatDuration(-delay)
+	default:
+		return ""
+	}
+}
+
+// BoardDelay is the delay of the leg an airport board lists: departures
+// for departure boards and arrivals for arrival boards.
+func BoardDelay(f models.AirportFlight, arrivals bool) string {
+	leg := f.DepartureDetails
+	if arrivals {
+		leg = f.ArrivalDetails
+	}
+	delay, ok := leg.ComputedDelay()
+	if !ok {
+		return ""
+	}
+	return DelayText(delay)
+}
+
+// AircraftText names the aircraft type with its registration, as "Boeing
+// 737-800 (N123AA)". Types missing from the embedded table are shown by
+// their code, and the transponder address stands in for a missing
+// registration. It returns "" when nothing is known.
+func AircraftText(a models.Aircraft) string {
+	name := sanitize.TerminalString(a.ICAOType)
+	if name == "" {
+		name = sanitize.TerminalString(a.IATAType)
+	}
+	if t := aircraft.Lookup(a.ICAOType, a.IATAType); t != nil {
+		name = t.Name()
+	}
+
+	tail := sanitize.TerminalString(a.Registration)
+	if tail == "" && a.ICAO24 != "" {
+		tail = "hex " + sanitize.TerminalString(a.ICAO24)
+	}
+
+	switch {
+	case name != "" && tail != "":
+		return name + " (" + tail + ")"
+	case name != "":
+		return name
+	default:
+		return tail
+	}
+}
+
+// GateText formats a terminal and gate compactly for boards, as "T4 B22",
+// "B22" or "T4".
+func GateText(d models.LegDetails) string {
+	terminal := sanitize.TerminalString(d.Terminal)
+	gate := sanitize.TerminalString(d.Gate)
+	if terminal == "" {
+		return gate
+	}
+	return strings.TrimSpace("T" + terminal + " " + gate)
+}
+
+// PrintCachedIndicator prints a dim "(cached)", "(via opensky)" or
+// "(cached, via opensky)" label on its own line, or nothing when the result
+// is fresh and its source is unknown.
+func PrintCachedIndicator(cached bool, source string) {
+	if label := SourceLabel(cached, source); label != "" {
+		dimStyle.Printf("(%s)\n", label)
+	}
+}
+
+// SourceLabel describes where a result came from, e.g. "cached, via opensky".
+func SourceLabel(cached bool, source string) string {
+	source = sanitize.TerminalString(source)
+	switch {
+	case cached && source != "":
+		return "cached, via " + source
+	case cached:
+		return "cached"
+	case source != "":
+		return "via " + source
+	default:
+		return ""
+	}
 }
 
 // DimPrint prints a string in dim/faint style followed by a newline.
@@ -143,6 +391,16 @@ func NewSpinner(suffix string) *spinner.Spinner {
 	return s
 }
 
+// FlightDateText formats a YYYY-MM-DD flight date as "Tue, Mar 10 2026".
+// Anything else is shown as given.
+func FlightDateText(date string) string {
+	t, err := time.Parse(provider.DateLayout, date)
+	if err != nil {
+		return sanitize.TerminalString(date)
+	}
+	return t.Format("Mon, Jan 2 2006")
+}
+
 // FlightStatusLines returns the plain-text flight summary used by non-colored views.
 func FlightStatusLines(flight *models.Flight, now time.Time) []string {
 	flightNumber := sanitize.TerminalString(flight.FlightNumber)
@@ -151,18 +409,33 @@ func FlightStatusLines(flight *models.Flight, now time.Time) []string {
 	arrival := sanitize.TerminalString(flight.Arrival)
 	status := sanitize.TerminalString(flight.Status)
 
-	lines := []string{
-		"Flight:   " + flightNumber,
-		"Airline:  " + airline,
-		fmt.Sprintf("Route:    %s -> %s", departure, arrival),
-		"Status:   " + status,
+	lines := []string{"Flight:   " + flightNumber}
+	if len(flight.Codeshares) > 0 {
+		lines = append(lines, "Codeshare: "+codeshareList(flight.Codeshares))
+	}
+	if flight.FlightDate != "" {
+		lines = append(lines, "Date:     "+FlightDateText(flight.FlightDate))
+	}
+	lines = append(lines, "Airline:  "+airline)
+	if aircraft := AircraftText(flight.Aircraft); aircraft != "" {
+		lines = append(lines, "Aircraft: "+aircraft)
+	}
+	lines = append(lines, "Route:    "+routeText(departure, arrival))
+	if from := LegDetailsText(flight.DepartureDetails, flight.Departure); from != "" {
+		lines = append(lines, "From:     "+from)
+	}
+	if to := LegDetailsText(flight.ArrivalDetails, flight.Arrival); to != "" {
+		lines = append(lines, "To:       "+to)
 	}
+	lines = append(lines, "Status:   "+status)
 
 	if !flight.DepartureTime.IsZero() {
 		lines = append(lines, "Departure: "+formatFlightTimestamp(flight.DepartureTime))
+		lines = appendTimeBreakdown(lines, flight.DepartureDetails)
 	}
 	if !flight.ArrivalTime.IsZero() {
 		lines = append(lines, "Arrival:   "+formatFlightTimestamp(flight.ArrivalTime))
+		lines = appendTimeBreakdown(lines, flight.ArrivalDetails)
 	}
 
 	totalDuration, elapsed, remaining, hasTotal, hasElapsed, hasRemaining := flightTimingMetrics(flight.DepartureTime, flight.ArrivalTime, now)
@@ -182,11 +455,72 @@ func FlightStatusLines(flight *models.Flight, now time.Time) []string {
 			fmt.Sprintf("Altitude: %.0f ft", flight.Altitude),
 			fmt.Sprintf("Speed:    %.0f mph", flight.Speed),
 		)
+		if motion := MotionText(flight); motion != "" {
+			lines = append(lines, "Motion:   "+motion)
+		} else if flight.Heading != 0 {
+			lines = append(lines, fmt.Sprintf("Heading:  %.0f°", flight.Heading))
+		}
+	}
+	if !flight.LiveUpdated.IsZero() {
+		lines = append(lines, "Updated:  "+LiveUpdatedText(flight, now))
 	}
 
 	return lines
 }
 
+// levelRate is the vertical rate, in feet per minute, below which a
+// flight is described as level.
+const levelRate = 100
+
+// MotionText describes which way a flight is moving, as "Climbing 1,800
+// ft/min heading 274°", "Level heading 90°" or "On ground". It returns ""
+// when the provider reported no vertical rate or ground state.
+func MotionText(flight *models.Flight) string {
+	var motion string
+	switch {
+	case flight.OnGround:
+		motion = "On ground"
+	case flight.VerticalRate == 0:
+		return ""
+	case flight.VerticalRate >= levelRate:
+		motion = fmt.Sprintf("Climbing %s ft/min", formatThousands(int(math.Round(flight.VerticalRate))))
+	case flight.VerticalRate <= -levelRate:
+		motion = fmt.Sprintf("Descending %s ft/min", formatThousands(int(math.Round(-flight.VerticalRate))))
+	default:
+		motion = "Level"
+	}
+	if flight.Heading != 0 {
+		motion += fmt.Sprintf(" heading %.0f°", flight.Heading)
+	}
+	return motion
+}
+
+// LiveUpdatedText says when the live position was reported, as "14:32:05
+// (3m ago)", marking it stale past models.LiveStaleAfter.
+func LiveUpdatedText(flight *models.Flight, now time.Time) string {
+	age := now.Sub(flight.LiveUpdated)
+	ago := fmt.Sprintf("%ds ago", int(max(age, 0).Seconds()))
+	if age >= time.Minute {
+		ago = FormatDuration(age) + " ago"
+	}
+	if flight.LiveStale(now) {
+		ago += ", stale"
+	}
+	return fmt.Sprintf("%s (%s)", flight.LiveUpdated.Local().Format("15:04:05"), ago)
+}
+
+// formatThousands formats n with comma separators, e.g. "1,800".
+func formatThousands(n int) string {
+	if n < 0 {
+		return "-" + formatThousands(-n)
+	}
+	digits := fmt.Sprint(n)
+	for i := len(digits) - 3; i > 0; i -= 3 {
+		digits = digits[:i] + "," + digits[i:]
+	}
+	return digits
+}
+
 // SearchFlightLines returns the detailed plain-text route-search summary for one flight.
 func SearchFlightLines(flight models.AirportFlight) []string {
 	flightNumber := sanitize.TerminalString(flight.FlightNumber)
@@ -199,14 +533,22 @@ func SearchFlightLines(flight models.AirportFlight) []string {
 		"Flight:    " + flightNumber,
 		"Airline:   " + airline,
 		fmt.Sprintf("Route:     %s -> %s", origin, destination),
-		"Status:    " + status,
 	}
+	if from := LegDetailsText(flight.DepartureDetails, flight.Origin); from != "" {
+		lines = append(lines, "From:      "+from)
+	}
+	if to := LegDetailsText(flight.ArrivalDetails, flight.Destination); to != "" {
+		lines = append(lines, "To:        "+to)
+	}
+	lines = append(lines, "Status:    "+status)
 
 	if !flight.DepartureTime.IsZero() {
 		lines = append(lines, "Departure: "+formatFlightTimestamp(flight.DepartureTime))
+		lines = appendTimeBreakdown(lines, flight.DepartureDetails)
 	}
 	if !flight.ArrivalTime.IsZero() {
 		lines = append(lines, "Arrival:   "+formatFlightTimestamp(flight.ArrivalTime))
+		lines = appendTimeBreakdown(lines, flight.ArrivalDetails)
 	}
 	if flight.Latitude != 0 || flight.Longitude != 0 {
 		lines = append(lines,
@@ -223,15 +565,30 @@ func airportBoardTitle(airportCode, flightType string) string {
 	ft := strings.TrimSpace(flightType)
 	label := "Flights"
 	switch {
-	case strings.EqualFold(ft, "arrivals"), strings.EqualFold(ft, "arrival"):
+	case isArrivals(ft):
 		label = "Arrivals"
 	case strings.EqualFold(ft, "departures"), strings.EqualFold(ft, "departure"):
 		label = "Departures"
 	}
-	return fmt.Sprintf("%s for %s", label, sanitize.TerminalString(airportCode))
+	return fmt.Sprintf("%s for %s", label, AirportLabel(airportCode))
 }
 
-func printAirportFlightTable(flights []models.AirportFlight, title string) {
+// AirportLabel returns an airport code with its name when the airports
+// table knows it, e.g. "JFK (John F Kennedy International Airport)".
+func AirportLabel(code string) string {
+	label := sanitize.TerminalString(strings.ToUpper(strings.TrimSpace(code)))
+	if name := airports.Name(code); name != "" {
+		label += " (" + name + ")"
+	}
+	return label
+}
+
+func isArrivals(flightType string) bool {
+	ft := strings.TrimSpace(flightType)
+	return strings.EqualFold(ft, "arrivals") || strings.EqualFold(ft, "arrival")
+}
+
+func printAirportFlightTable(flights []models.AirportFlight, title string, arrivals bool) {
 	labelStyle.Printf("%s:\n\n", title)
 	if len(flights) == 0 {
 		dimStyle.Println("  No flights found.")
@@ -239,15 +596,33 @@ func printAirportFlightTable(flights []models.AirportFlight, title string) {
 	}
 
 	for _, f := range flights {
-		fmt.Println(airportFlightRow(f))
+		fmt.Println(airportFlightRow(f, arrivals))
+	}
+}
+
+// BoardGate is the gate column of an airport board. Departure boards show
+// the gate passengers leave from; arrival boards show the gate and baggage
+// belt at the board's airport, as "T4 41 belt 3".
+func BoardGate(f models.AirportFlight, arrivals bool) string {
+	if !arrivals {
+		return GateText(f.DepartureDetails)
+	}
+	gate := GateText(f.ArrivalDetails)
+	if baggage := sanitize.TerminalString(f.ArrivalDetails.Baggage); baggage != "" {
+		gate = strings.TrimSpace(gate + " belt " + baggage)
 	}
+	return gate
 }
 
-func airportFlightRow(f models.AirportFlight) string {
+func airportFlightRow(f models.AirportFlight, arrivals bool) string {
 	timeStr := ""
 	if !f.ScheduledTime.IsZero() {
 		timeStr = f.ScheduledTime.Format("15:04")
 	}
+	if delay := BoardDelay(f, arrivals); delay != "" {
+		timeStr = strings.TrimSpace(timeStr + " " + delay)
+	}
+	gate := BoardGate(f, arrivals)
 	flightNumber := sanitize.TerminalString(f.FlightNumber)
 	airline := sanitize.TerminalString(f.Airline)
 	origin := sanitize.TerminalString(f.Origin)
@@ -257,8 +632,15 @@ func airportFlightRow(f models.AirportFlight) string {
 	// Color is applied to status as a trailing field to avoid ANSI codes
 	// disrupting fixed-width padding on earlier columns.
 	coloredStatus := StatusColor(status).Sprint(status)
-	return fmt.Sprintf("  %-10s %-25s %-15s %s  %s",
+	row := fmt.Sprintf("  %-10s %-25s %-15s %s  %s",
 		flightNumber, airline, route, coloredStatus, timeStr)
+	if gate != "" {
+		row += "  " + dimStyle.Sprint(gate)
+	}
+	if codeshares := BoardCodeshares(f); codeshares != "" {
+		row += "  " + dimStyle.Sprint(codeshares)
+	}
+	return row
 }
 
 func printSearchFlight(f models.AirportFlight) {
@@ -277,16 +659,27 @@ func printSearchFlight(f models.AirportFlight) {
 	labelStyle.Print("Route:     ")
 	fmt.Printf("%s -> %s\n", origin, destination)
 
+	if from := LegDetailsText(f.DepartureDetails, f.Origin); from != "" {
+		labelStyle.Print("From:      ")
+		fmt.Println(from)
+	}
+	if to := LegDetailsText(f.ArrivalDetails, f.Destination); to != "" {
+		labelStyle.Print("To:        ")
+		fmt.Println(to)
+	}
+
 	labelStyle.Print("Status:    ")
 	StatusColor(status).Println(status)
 
 	if !f.DepartureTime.IsZero() {
 		labelStyle.Print("Departure:")
 		fmt.Printf(" %s\n", formatFlightTimestamp(f.DepartureTime))
+		printTimeBreakdown(f.DepartureDetails)
 	}
 	if !f.ArrivalTime.IsZero() {
 		labelStyle.Print("Arrival:  ")
 		fmt.Printf(" %s\n", formatFlightTimestamp(f.ArrivalTime))
+		printTimeBreakdown(f.ArrivalDetails)
 	}
 	if f.Latitude != 0 || f.Longitude != 0 {
 		labelStyle.Print("Location:  ")
@@ -298,6 +691,32 @@ func printSearchFlight(f models.AirportFlight) {
 	}
 }
 
+// routeText formats "JFK -> LAX", or "Unknown" when a provider (such as a
+// state-vector feed) has no route information at all.
+func routeText(from, to string) string {
+	if from == "" && to == "" {
+		return "Unknown"
+	}
+	return fmt.Sprintf("%s -> %s", from, to)
+}
+
+// timeBreakdownIndent lines a time breakdown up under the timestamp on the
+// "Departure: " line above it.
+const timeBreakdownIndent = "           "
+
+func appendTimeBreakdown(lines []string, d models.LegDetails) []string {
+	if breakdown := TimeBreakdown(d); breakdown != "" {
+		lines = append(lines, timeBreakdownIndent+breakdown)
+	}
+	return lines
+}
+
+func printTimeBreakdown(d models.LegDetails) {
+	if breakdown := TimeBreakdown(d); breakdown != "" {
+		fmt.Println(timeBreakdownIndent + breakdown)
+	}
+}
+
 func formatFlightTimestamp(t time.Time) string {
 	return t.Format(time.RFC1123)
 }
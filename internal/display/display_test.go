@@ -1,6 +1,7 @@
 package display
 
 import (
+	"fmt"
 	"io"
 	"os"
 	"strings"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/joshuachuah/flightcli/internal/models"
+	"github.com/joshuachuah/flightcli/internal/provider"
 )
 
 func captureStdout(t *testing.T, fn func()) string {
@@ -43,12 +45,15 @@ func captureStdout(t *testing.T, fn func()) string {
 }
 
 func TestAirportBoardTitle(t *testing.T) {
-	if got := airportBoardTitle("JFK", "departures"); got != "Departures for JFK" {
+	if got := airportBoardTitle("JFK", "departures"); got != "Departures for JFK (John F Kennedy International Airport)" {
 		t.Fatalf("unexpected departures title: %q", got)
 	}
-	if got := airportBoardTitle("JFK", "arrivals"); got != "Arrivals for JFK" {
+	if got := airportBoardTitle("JFK", "arrivals"); got != "Arrivals for JFK (John F Kennedy International Airport)" {
 		t.Fatalf("unexpected arrivals title: %q", got)
 	}
+	if got := airportBoardTitle("YXU", "arrivals"); got != "Arrivals for YXU" {
+		t.Fatalf("expected unlisted airport to show its code only, got %q", got)
+	}
 }
 
 func TestAirportFlightRow(t *testing.T) {
@@ -65,7 +70,7 @@ func TestAirportFlightRow(t *testing.T) {
 		Destination:   "LAX",
 		Status:        "In Flight",
 		ScheduledTime: time.Date(2026, time.March, 14, 15, 30, 0, 0, time.UTC),
-	})
+	}, false)
 
 	for _, part := range []string{"AA100", "American Airlines", "JFK -> LAX", "In Flight", "15:30"} {
 		if !strings.Contains(row, part) {
@@ -126,7 +131,7 @@ func TestPrintSearchResultsUsesSharedRowFormatting(t *testing.T) {
 		}, "JFK", "LAX")
 	})
 
-	for _, part := range []string{"Flights from JFK to LAX", "Departure:", "Arrival:", "Location:", "Altitude:", "Speed:"} {
+	for _, part := range []string{"Flights from JFK (John F Kennedy International Airport) to LAX (Los Angeles International Airport)", "Departure:", "Arrival:", "Location:", "Altitude:", "Speed:"} {
 		if !strings.Contains(output, part) {
 			t.Fatalf("search output %q missing %q", output, part)
 		}
@@ -155,6 +160,255 @@ func TestFlightStatusLinesIncludesElapsedAndRemaining(t *testing.T) {
 	}
 }
 
+func TestFlightStatusLinesIncludesHeadingAndUnknownRoute(t *testing.T) {
+	lines := FlightStatusLines(&models.Flight{
+		FlightNumber: "UA2189",
+		Airline:      "United Airlines",
+		Status:       "In Flight",
+		Latitude:     40.7128,
+		Longitude:    -73.9352,
+		Heading:      274.5,
+	}, time.Now())
+
+	output := strings.Join(lines, "\n")
+	for _, part := range []string{"Route:    Unknown", "Heading:  274°"} {
+		if !strings.Contains(output, part) {
+			t.Fatalf("flight lines %q missing %q", output, part)
+		}
+	}
+}
+
+func TestFlightStatusLinesIncludesGates(t *testing.T) {
+	lines := FlightStatusLines(&models.Flight{
+		FlightNumber: "AA100",
+		Airline:      "American Airlines",
+		Departure:    "JFK",
+		Arrival:      "LAX",
+		Status:       "Scheduled",
+		DepartureDetails: models.LegDetails{
+			Airport:  "John F Kennedy International",
+			Terminal: "8",
+			Gate:     "B22",
+		},
+		ArrivalDetails: models.LegDetails{Airport: "Los Angeles International", Baggage: "3"},
+	}, time.Now())
+
+	output := strings.Join(lines, "\n")
+	for _, part := range []string{
+		"From:     John F Kennedy International · Terminal 8 · Gate B22",
+		"To:       Los Angeles International · Baggage 3",
+	} {
+		if !strings.Contains(output, part) {
+			t.Fatalf("flight lines %q missing %q", output, part)
+		}
+	}
+}
+
+func TestFlightStatusLinesBreakDownLateDeparture(t *testing.T) {
+	scheduled := time.Date(2026, time.March, 13, 14, 5, 0, 0, time.UTC)
+	lines := FlightStatusLines(&models.Flight{
+		FlightNumber:     "AA100",
+		Departure:        "JFK",
+		Arrival:          "LAX",
+		Status:           "Scheduled",
+		DepartureTime:    scheduled.Add(35 * time.Minute),
+		DepartureDetails: models.LegDetails{Scheduled: scheduled, Estimated: scheduled.Add(35 * time.Minute)},
+	}, scheduled)
+
+	output := strings.Join(lines, "\n")
+	if want := "\n           Scheduled 14:05 · Estimated 14:40 (+35m)"; !strings.Contains(output, want) {
+		t.Fatalf("flight lines %q missing %q", output, want)
+	}
+}
+
+func TestFlightStatusLinesNameAircraft(t *testing.T) {
+	lines := FlightStatusLines(&models.Flight{
+		FlightNumber: "AA100",
+		Airline:      "American Airlines",
+		Aircraft:     models.Aircraft{Registration: "N123AA", ICAOType: "B738"},
+	}, time.Now())
+
+	output := strings.Join(lines, "\n")
+	if want := "Airline:  American Airlines\nAircraft: Boeing 737-800 (N123AA)"; !strings.Contains(output, want) {
+		t.Fatalf("flight lines %q missing %q", output, want)
+	}
+}
+
+func TestAircraftText(t *testing.T) {
+	tests := []struct {
+		name     string
+		aircraft models.Aircraft
+		want     string
+	}{
+		{"type from IATA code", models.Aircraft{IATAType: "351", Registration: "G-XWBA"}, "Airbus A350-1000 (G-XWBA)"},
+		{"unknown type shows code", models.Aircraft{ICAOType: "ZZZZ", Registration: "N1"}, "ZZZZ (N1)"},
+		{"hex without registration", models.Aircraft{ICAOType: "B38M", ICAO24: "A1B2C3"}, "Boeing 737 MAX 8 (hex A1B2C3)"},
+		{"nothing known", models.Aircraft{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AircraftText(tt.aircraft); got != tt.want {
+				t.Fatalf("AircraftText = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlightStatusLinesListCodeshares(t *testing.T) {
+	lines := FlightStatusLines(&models.Flight{FlightNumber: "BA178", Codeshares: []string{"AA6250"}}, time.Now())
+	if len(lines) < 2 || lines[1] != "Codeshare: AA6250" {
+		t.Fatalf("expected codeshare line after the flight number, got %q", lines)
+	}
+}
+
+func TestAirportFlightRowNotesCodeshares(t *testing.T) {
+	originalNoColor := color.NoColor
+	color.NoColor = true
+	t.Cleanup(func() {
+		color.NoColor = originalNoColor
+	})
+
+	operating := airportFlightRow(models.AirportFlight{FlightNumber: "AA100", Codeshares: []string{"IB4218", "BA1511"}}, false)
+	if !strings.HasSuffix(operating, "also IB4218, BA1511") {
+		t.Fatalf("expected folded codeshares listed, got %q", operating)
+	}
+	codeshare := airportFlightRow(models.AirportFlight{FlightNumber: "IB4218", OperatingFlightNumber: "AA100", OperatingAirline: "American Airlines"}, false)
+	if !strings.HasSuffix(codeshare, "operated by American Airlines AA100") {
+		t.Fatalf("expected operating flight named, got %q", codeshare)
+	}
+}
+
+func TestFlightStatusLinesDescribeMotionAndStaleness(t *testing.T) {
+	now := time.Date(2026, time.March, 13, 12, 0, 0, 0, time.Local)
+	lines := FlightStatusLines(&models.Flight{
+		FlightNumber: "UA2189",
+		Latitude:     40.7,
+		Longitude:    -73.9,
+		Heading:      274,
+		VerticalRate: 1800,
+		LiveUpdated:  now.Add(-12 * time.Minute),
+	}, now)
+
+	output := strings.Join(lines, "\n")
+	for _, part := range []string{"Motion:   Climbing 1,800 ft/min heading 274°", "Updated:  11:48:00 (12m ago, stale)"} {
+		if !strings.Contains(output, part) {
+			t.Fatalf("flight lines %q missing %q", output, part)
+		}
+	}
+	if strings.Contains(output, "Heading:") {
+		t.Fatalf("expected heading folded into the motion line, got %q", output)
+	}
+}
+
+func TestMotionText(t *testing.T) {
+	tests := []struct {
+		name   string
+		flight models.Flight
+		want   string
+	}{
+		{"descending", models.Flight{VerticalRate: -1216.4, Heading: 90}, "Descending 1,216 ft/min heading 90°"},
+		{"level", models.Flight{VerticalRate: 64}, "Level"},
+		{"on ground", models.Flight{OnGround: true, Heading: 181}, "On ground heading 181°"},
+		{"unknown", models.Flight{Heading: 274}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MotionText(&tt.flight); got != tt.want {
+				t.Fatalf("MotionText = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLiveUpdatedTextFreshPosition(t *testing.T) {
+	now := time.Date(2026, time.March, 13, 12, 0, 0, 0, time.Local)
+	flight := &models.Flight{LiveUpdated: now.Add(-8 * time.Second)}
+	if got, want := LiveUpdatedText(flight, now), "11:59:52 (8s ago)"; got != want {
+		t.Fatalf("LiveUpdatedText = %q, want %q", got, want)
+	}
+}
+
+func TestTimeBreakdown(t *testing.T) {
+	scheduled := time.Date(2026, time.March, 13, 14, 5, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		leg  models.LegDetails
+		want string
+	}{
+		{"schedule only", models.LegDetails{Scheduled: scheduled}, ""},
+		{"on time", models.LegDetails{Scheduled: scheduled, Estimated: scheduled}, "Scheduled 14:05 · Estimated 14:05"},
+		{"actual wins over estimate", models.LegDetails{Scheduled: scheduled, Estimated: scheduled.Add(20 * time.Minute), Actual: scheduled.Add(75 * time.Minute)}, "Scheduled 14:05 · Estimated 14:25 · Actual 15:20 (+1h 15m)"},
+		{"early", models.LegDetails{Scheduled: scheduled, Actual: scheduled.Add(-6 * time.Minute)}, "Scheduled 14:05 · Actual 13:59 (-6m)"},
+		{"reported delay only", models.LegDetails{Scheduled: scheduled, Delay: 35}, "Scheduled 14:05 (+35m)"},
+		{"nothing known", models.LegDetails{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TimeBreakdown(tt.leg); got != tt.want {
+				t.Fatalf("TimeBreakdown = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlightStatusLinesOmitsUnknownLegDetails(t *testing.T) {
+	lines := FlightStatusLines(&models.Flight{FlightNumber: "AA100", Departure: "YXU", Arrival: "YQT"}, time.Now())
+	output := strings.Join(lines, "\n")
+	if strings.Contains(output, "From:") || strings.Contains(output, "To:") {
+		t.Fatalf("expected no leg detail lines, got %q", output)
+	}
+}
+
+func TestLegDetailsTextFallsBackToAirportName(t *testing.T) {
+	if got := LegDetailsText(models.LegDetails{Terminal: "4"}, "jfk"); got != "John F Kennedy International Airport · Terminal 4" {
+		t.Fatalf("expected dataset name for JFK, got %q", got)
+	}
+	if got := LegDetailsText(models.LegDetails{Airport: "JFK Intl"}, "JFK"); got != "JFK Intl" {
+		t.Fatalf("expected provider name to win, got %q", got)
+	}
+}
+
+func TestAirportFlightRowShowsBoardSideGate(t *testing.T) {
+	originalNoColor := color.NoColor
+	color.NoColor = true
+	t.Cleanup(func() {
+		color.NoColor = originalNoColor
+	})
+
+	f := models.AirportFlight{
+		FlightNumber:     "AA100",
+		Origin:           "JFK",
+		Destination:      "LAX",
+		DepartureDetails: models.LegDetails{Terminal: "8", Gate: "B22"},
+		ArrivalDetails:   models.LegDetails{Terminal: "4", Gate: "41", Baggage: "3"},
+	}
+	if row := airportFlightRow(f, false); !strings.HasSuffix(row, "T8 B22") {
+		t.Fatalf("expected departure gate on departures board, got %q", row)
+	}
+	if row := airportFlightRow(f, true); !strings.HasSuffix(row, "T4 41 belt 3") {
+		t.Fatalf("expected arrival gate and belt on arrivals board, got %q", row)
+	}
+}
+
+func TestAirportFlightRowShowsDelayNextToTime(t *testing.T) {
+	originalNoColor := color.NoColor
+	color.NoColor = true
+	t.Cleanup(func() {
+		color.NoColor = originalNoColor
+	})
+
+	scheduled := time.Date(2026, time.March, 13, 14, 5, 0, 0, time.UTC)
+	row := airportFlightRow(models.AirportFlight{
+		FlightNumber:     "AA100",
+		Status:           "Scheduled",
+		ScheduledTime:    scheduled,
+		DepartureDetails: models.LegDetails{Scheduled: scheduled, Estimated: scheduled.Add(35 * time.Minute)},
+	}, false)
+	if !strings.HasSuffix(row, "14:05 +35m") {
+		t.Fatalf("expected departure delay after the time, got %q", row)
+	}
+}
+
 func TestFlightStatusLinesSanitizesTerminalControls(t *testing.T) {
 	lines := FlightStatusLines(&models.Flight{
 		FlightNumber: "AA100\x1b[31m",
@@ -263,7 +517,7 @@ func TestAirportFlightRowSanitizesTerminalControls(t *testing.T) {
 		Origin:       "JFK",
 		Destination:  "LAX\x00",
 		Status:       "Scheduled\x1b[31m",
-	})
+	}, false)
 
 	for _, forbidden := range []string{"\x1b", "\x00", "spoof"} {
 		if strings.Contains(row, forbidden) {
@@ -306,3 +560,44 @@ func TestPrintSearchResultsIncludeZeroTelemetryWhenLocationExists(t *testing.T)
 		}
 	}
 }
+
+func TestSourceLabel(t *testing.T) {
+	tests := []struct {
+		cached bool
+		source string
+		want   string
+	}{
+		{true, "opensky", "cached, via opensky"},
+		{true, "", "cached"},
+		{false, "adsb", "via adsb"},
+		{false, "", ""},
+	}
+	for _, tt := range tests {
+		if got := SourceLabel(tt.cached, tt.source); got != tt.want {
+			t.Errorf("SourceLabel(%v, %q) = %q, want %q", tt.cached, tt.source, got, tt.want)
+		}
+	}
+}
+
+func TestErrorHelp(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{&provider.APIError{StatusCode: 401, Code: "invalid_access_key"}, "AVIATIONSTACK_API_KEY"},
+		{fmt.Errorf("fetching: %w", &provider.APIError{StatusCode: 200, Code: "usage_limit_reached"}), "monthly request allowance"},
+		{&provider.APIError{StatusCode: 200, Code: "https_access_restricted"}, "--aviationstack-url http://"},
+		{&provider.APIError{StatusCode: 403, Code: "function_access_restricted"}, "does not include this request"},
+		{&provider.APIError{StatusCode: 429}, "Wait a minute"},
+	}
+	for _, tt := range tests {
+		help := strings.Join(ErrorHelp(tt.err), "\n")
+		if !strings.Contains(help, tt.want) {
+			t.Errorf("ErrorHelp(%v) = %q, want it to mention %q", tt.err, help, tt.want)
+		}
+	}
+
+	if help := ErrorHelp(fmt.Errorf("network unreachable")); help != nil {
+		t.Fatalf("expected no help for an unrelated error, got %q", help)
+	}
+}
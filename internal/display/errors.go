@@ -0,0 +1,55 @@
+/*
+Copyright 2026 Joshua Chuah <jchuah07@gmail.com>
+*/
+package display
+
+import (
+	"errors"
+
+	"github.com/joshuachuah/flightcli/internal/provider"
+	"github.com/joshuachuah/flightcli/internal/quota"
+)
+
+// ErrorHelp returns remediation steps for provider errors the user can fix,
+// or nil when there is nothing specific to suggest.
+func ErrorHelp(err error) []string {
+	var apiErr *provider.APIError
+	switch {
+	case errors.Is(err, provider.ErrInvalidAPIKey):
+		return []string{
+			"AviationStack rejected your API key.",
+			"Check AVIATIONSTACK_API_KEY in your shell or .env file against",
+			"the key shown at https://aviationstack.com/dashboard",
+		}
+	case errors.Is(err, provider.ErrQuotaExceeded):
+		return []string{
+			"Your AviationStack plan's monthly request allowance is used up.",
+			"Cached results still work. Wait for the allowance to reset, upgrade",
+			"your plan, or use another source with --provider opensky.",
+		}
+	case errors.Is(err, quota.ErrBudgetExceeded):
+		return []string{
+			"This month's AviationStack call budget is used up (--hard-limit).",
+			"Cached results still work. Check usage with 'flightcli quota', raise",
+			"--quota-limit if your plan allows more, or drop --hard-limit.",
+		}
+	case errors.As(err, &apiErr) && apiErr.HTTPSRestricted():
+		return []string{
+			"Your AviationStack plan does not allow HTTPS requests.",
+			"Use the plain http endpoint (your key is then sent unencrypted):",
+			"  --aviationstack-url http://api.aviationstack.com/v1/flights",
+		}
+	case errors.Is(err, provider.ErrFunctionRestricted):
+		return []string{
+			"Your AviationStack plan does not include this request.",
+			"Upgrade your plan, or use another source with --provider opensky.",
+		}
+	case errors.Is(err, provider.ErrRateLimited):
+		return []string{
+			"AviationStack is limiting how fast requests can be sent.",
+			"Wait a minute and try again.",
+		}
+	default:
+		return nil
+	}
+}
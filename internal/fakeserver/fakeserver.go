@@ -0,0 +1,363 @@
+// Package fakeserver serves a stand-in for the AviationStack /v1/flights
+// and /v1/flightsFuture endpoints from local fixtures, so the CLI can run
+// end to end with no network. It can also inject rate limits, server
+// errors and slow responses to exercise error handling.
+package fakeserver
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed fixtures/flights.json
+var defaultFixtures []byte
+
+//go:embed fixtures/schedules.json
+var defaultSchedules []byte
+
+// schedulePrefix marks fixture files holding flightsFuture timetable rows
+// rather than flights, such as schedules.json.
+const schedulePrefix = "schedules"
+
+const (
+	defaultLimit = 100
+	maxLimit     = 100
+)
+
+// Flight is one fixture record. Raw is served back exactly as loaded; the
+// other fields are only read for filtering.
+type Flight struct {
+	Raw json.RawMessage
+
+	FlightIATA string
+	FlightICAO string
+	DepIATA    string
+	ArrIATA    string
+	FlightDate string
+}
+
+// Schedule is one flightsFuture timetable row. Raw is served back exactly
+// as loaded, for any date; the airport codes are only read for filtering.
+type Schedule struct {
+	Raw json.RawMessage
+
+	DepIATA string
+	ArrIATA string
+}
+
+// Faults describes failures to inject. A zero Faults serves every request
+// normally.
+type Faults struct {
+	// Status, when 429 or 500, is returned instead of data.
+	Status int
+	// Delay is added before every affected response.
+	Delay time.Duration
+	// Every applies the faults to every Nth request only. Zero or one
+	// means every request.
+	Every int
+}
+
+// Server answers /v1/flights from Flights and /v1/flightsFuture from
+// Schedules.
+type Server struct {
+	Flights   []Flight
+	Schedules []Schedule
+	Faults    Faults
+
+	mu       sync.Mutex
+	requests int
+}
+
+// New returns a server for the fixtures in dir, or the built-in fixtures
+// when dir is empty. Files in dir whose names start with "schedules" hold
+// timetable rows; the rest hold flights.
+func New(dir string) (*Server, error) {
+	if dir == "" {
+		flights, err := ParseFixtures(defaultFixtures)
+		if err != nil {
+			return nil, fmt.Errorf("parsing built-in fixtures: %w", err)
+		}
+		schedules, err := ParseSchedules(defaultSchedules)
+		if err != nil {
+			return nil, fmt.Errorf("parsing built-in schedules: %w", err)
+		}
+		return &Server{Flights: flights, Schedules: schedules}, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("listing fixtures: %w", err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no *.json fixtures found in %s", dir)
+	}
+	sort.Strings(files)
+
+	s := &Server{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("reading fixture: %w", err)
+		}
+		if strings.HasPrefix(filepath.Base(file), schedulePrefix) {
+			schedules, err := ParseSchedules(data)
+			if err != nil {
+				return nil, fmt.Errorf("parsing fixture %s: %w", filepath.Base(file), err)
+			}
+			s.Schedules = append(s.Schedules, schedules...)
+			continue
+		}
+		flights, err := ParseFixtures(data)
+		if err != nil {
+			return nil, fmt.Errorf("parsing fixture %s: %w", filepath.Base(file), err)
+		}
+		s.Flights = append(s.Flights, flights...)
+	}
+	return s, nil
+}
+
+// ParseFixtures reads either a full AviationStack response ({"data": [...]})
+// or a bare array of flight objects.
+func ParseFixtures(data []byte) ([]Flight, error) {
+	raws, err := parseRows(data)
+	if err != nil {
+		return nil, err
+	}
+
+	flights := make([]Flight, 0, len(raws))
+	for _, raw := range raws {
+		var f struct {
+			FlightDate string `json:"flight_date"`
+			Departure  struct {
+				IATA string `json:"iata"`
+			} `json:"departure"`
+			Arrival struct {
+				IATA string `json:"iata"`
+			} `json:"arrival"`
+			Flight struct {
+				IATA string `json:"iata"`
+				ICAO string `json:"icao"`
+			} `json:"flight"`
+		}
+		if err := json.Unmarshal(raw, &f); err != nil {
+			return nil, err
+		}
+		flights = append(flights, Flight{
+			Raw:        raw,
+			FlightIATA: f.Flight.IATA,
+			FlightICAO: f.Flight.ICAO,
+			DepIATA:    f.Departure.IATA,
+			ArrIATA:    f.Arrival.IATA,
+			FlightDate: f.FlightDate,
+		})
+	}
+	return flights, nil
+}
+
+// ParseSchedules reads flightsFuture timetable rows, as a full response or
+// a bare array, in the same way as ParseFixtures.
+func ParseSchedules(data []byte) ([]Schedule, error) {
+	raws, err := parseRows(data)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]Schedule, 0, len(raws))
+	for _, raw := range raws {
+		var row struct {
+			Departure struct {
+				IATACode string `json:"iataCode"`
+			} `json:"departure"`
+			Arrival struct {
+				IATACode string `json:"iataCode"`
+			} `json:"arrival"`
+		}
+		if err := json.Unmarshal(raw, &row); err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, Schedule{Raw: raw, DepIATA: row.Departure.IATACode, ArrIATA: row.Arrival.IATACode})
+	}
+	return schedules, nil
+}
+
+func parseRows(data []byte) ([]json.RawMessage, error) {
+	var raws []json.RawMessage
+	var envelope struct {
+		Data []json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(data, &envelope); err == nil {
+		return envelope.Data, nil
+	}
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return nil, fmt.Errorf("expected {\"data\": [...]} or an array of rows")
+	}
+	return raws, nil
+}
+
+type pagination struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+	Count  int `json:"count"`
+	Total  int `json:"total"`
+}
+
+type response struct {
+	Pagination pagination        `json:"pagination"`
+	Data       []json.RawMessage `json:"data"`
+}
+
+type apiError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/v1/flights" && r.URL.Path != "/v1/flightsFuture" {
+		writeError(w, http.StatusNotFound, "invalid_api_function", "This API function does not exist.")
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Only GET is supported.")
+		return
+	}
+
+	if s.faulty() {
+		if s.Faults.Delay > 0 {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(s.Faults.Delay):
+			}
+		}
+		switch s.Faults.Status {
+		case http.StatusTooManyRequests:
+			w.Header().Set("Retry-After", "1")
+			writeError(w, http.StatusTooManyRequests, "rate_limit_reached", "Your request rate limit has been reached.")
+			return
+		case http.StatusInternalServerError:
+			writeError(w, http.StatusInternalServerError, "internal_error", "An internal error occurred.")
+			return
+		}
+	}
+
+	query := r.URL.Query()
+	if query.Get("access_key") == "" {
+		writeError(w, http.StatusUnauthorized, "missing_access_key", "You have not supplied an API Access Key.")
+		return
+	}
+
+	limit, err := intParam(query.Get("limit"), defaultLimit)
+	if err != nil || limit < 1 || limit > maxLimit {
+		writeError(w, http.StatusUnprocessableEntity, "invalid_limit", fmt.Sprintf("limit must be between 1 and %d.", maxLimit))
+		return
+	}
+	offset, err := intParam(query.Get("offset"), 0)
+	if err != nil || offset < 0 {
+		writeError(w, http.StatusUnprocessableEntity, "invalid_offset", "offset must be zero or more.")
+		return
+	}
+
+	var matched []json.RawMessage
+	if r.URL.Path == "/v1/flightsFuture" {
+		var problem string
+		if matched, problem = s.matchSchedules(query); problem != "" {
+			writeError(w, http.StatusUnprocessableEntity, "validation_error", problem)
+			return
+		}
+	} else {
+		for _, f := range s.Flights {
+			if matches(query.Get("flight_iata"), f.FlightIATA) &&
+				matches(query.Get("flight_icao"), f.FlightICAO) &&
+				matches(query.Get("dep_iata"), f.DepIATA) &&
+				matches(query.Get("arr_iata"), f.ArrIATA) &&
+				matches(query.Get("flight_date"), f.FlightDate) {
+				matched = append(matched, f.Raw)
+			}
+		}
+	}
+
+	page := []json.RawMessage{}
+	if offset < len(matched) {
+		page = matched[offset:min(offset+limit, len(matched))]
+	}
+	writeJSON(w, http.StatusOK, response{
+		Pagination: pagination{Limit: limit, Offset: offset, Count: len(page), Total: len(matched)},
+		Data:       page,
+	})
+}
+
+// matchSchedules returns the timetable rows for a flightsFuture query, or
+// why the query is invalid. Like AviationStack it needs an airport, a
+// direction and a date; the fixtures are a weekly timetable, so every
+// valid date gets the same rows.
+func (s *Server) matchSchedules(query url.Values) ([]json.RawMessage, string) {
+	airport := query.Get("iataCode")
+	if airport == "" {
+		return nil, "iataCode is required."
+	}
+	direction := query.Get("type")
+	if direction != "departure" && direction != "arrival" {
+		return nil, "type must be departure or arrival."
+	}
+	if _, err := time.Parse("2006-01-02", query.Get("date")); err != nil {
+		return nil, "date must be given as YYYY-MM-DD."
+	}
+
+	var matched []json.RawMessage
+	for _, row := range s.Schedules {
+		code := row.DepIATA
+		if direction == "arrival" {
+			code = row.ArrIATA
+		}
+		if strings.EqualFold(airport, code) {
+			matched = append(matched, row.Raw)
+		}
+	}
+	return matched, ""
+}
+
+// faulty counts the request and reports whether faults apply to it.
+func (s *Server) faulty() bool {
+	if s.Faults.Status == 0 && s.Faults.Delay == 0 {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	return s.Faults.Every <= 1 || s.requests%s.Faults.Every == 0
+}
+
+func matches(filter, value string) bool {
+	return filter == "" || strings.EqualFold(filter, value)
+}
+
+func intParam(s string, fallback int) (int, error) {
+	if s == "" {
+		return fallback, nil
+	}
+	return strconv.Atoi(s)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, struct {
+		Error apiError `json:"error"`
+	}{apiError{Code: code, Message: message}})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; Charset=UTF-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,249 @@
+package fakeserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/provider"
+)
+
+func get(t *testing.T, s *Server, target string) (*httptest.ResponseRecorder, response) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+
+	var body response
+	if rec.Code == http.StatusOK {
+		if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+	}
+	return rec, body
+}
+
+func TestServerFiltersBuiltInFixtures(t *testing.T) {
+	s, err := New("")
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{"flight_iata=ua2189", 1},
+		{"flight_icao=UAL2189", 1},
+		{"dep_iata=EWR", 2},
+		{"dep_iata=EWR&arr_iata=SFO", 2},
+		{"arr_iata=JFK", 1},
+		{"dep_iata=XXX", 0},
+		{"flight_iata=UA2189&flight_date=2026-03-13", 1},
+		{"flight_iata=UA2189&flight_date=2026-03-12", 0},
+	}
+	for _, tt := range tests {
+		rec, body := get(t, s, "/v1/flights?access_key=test&"+tt.query)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: expected 200, got %d", tt.query, rec.Code)
+		}
+		if len(body.Data) != tt.want || body.Pagination.Total != tt.want {
+			t.Fatalf("%s: expected %d flights, got %d (total %d)", tt.query, tt.want, len(body.Data), body.Pagination.Total)
+		}
+	}
+}
+
+func TestServerPaginates(t *testing.T) {
+	s, err := New("")
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	_, body := get(t, s, "/v1/flights?access_key=test&limit=2&offset=1")
+	if body.Pagination.Limit != 2 || body.Pagination.Offset != 1 || body.Pagination.Count != 2 || len(body.Data) != 2 {
+		t.Fatalf("unexpected pagination: %#v with %d rows", body.Pagination, len(body.Data))
+	}
+	if body.Pagination.Total != len(s.Flights) {
+		t.Fatalf("expected total %d, got %d", len(s.Flights), body.Pagination.Total)
+	}
+
+	rec, _ := get(t, s, "/v1/flights?access_key=test&limit=1000")
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("expected oversized limit to be rejected, got %d", rec.Code)
+	}
+}
+
+func TestServerRequiresAccessKey(t *testing.T) {
+	s := &Server{}
+	rec, _ := get(t, s, "/v1/flights")
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", rec.Code)
+	}
+}
+
+func TestServerInjectsFaultsOnEveryNthRequest(t *testing.T) {
+	s := &Server{Faults: Faults{Status: http.StatusTooManyRequests, Every: 2}}
+
+	want := []int{http.StatusOK, http.StatusTooManyRequests, http.StatusOK, http.StatusTooManyRequests}
+	for i, status := range want {
+		rec, _ := get(t, s, "/v1/flights?access_key=test")
+		if rec.Code != status {
+			t.Fatalf("request %d: expected %d, got %d", i+1, status, rec.Code)
+		}
+		if status == http.StatusTooManyRequests && rec.Header().Get("Retry-After") == "" {
+			t.Fatal("expected Retry-After on rate-limited response")
+		}
+	}
+}
+
+func TestNewLoadsFixtureDirectory(t *testing.T) {
+	dir := t.TempDir()
+	bare := `[{"flight":{"iata":"ZZ1"},"departure":{"iata":"AAA"},"arrival":{"iata":"BBB"}}]`
+	full := `{"data":[{"flight":{"iata":"ZZ2"},"departure":{"iata":"BBB"},"arrival":{"iata":"AAA"}}]}`
+	schedules := `[{"departure":{"iataCode":"aaa"},"arrival":{"iataCode":"bbb"}}]`
+	for name, content := range map[string]string{"a.json": bare, "b.json": full, "schedules.json": schedules, "notes.txt": "ignored"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatalf("write fixture: %v", err)
+		}
+	}
+
+	s, err := New(dir)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	if len(s.Flights) != 2 || s.Flights[0].FlightIATA != "ZZ1" || s.Flights[1].DepIATA != "BBB" {
+		t.Fatalf("unexpected fixtures: %#v", s.Flights)
+	}
+	if len(s.Schedules) != 1 || s.Schedules[0].DepIATA != "aaa" {
+		t.Fatalf("unexpected schedules: %#v", s.Schedules)
+	}
+
+	if _, err := New(t.TempDir()); err == nil {
+		t.Fatal("expected empty fixture directory to return an error")
+	}
+}
+
+func TestAviationStackProviderAgainstFakeServer(t *testing.T) {
+	s, err := New("")
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	p := &provider.AviationStackProvider{APIKey: "test", Endpoint: server.URL + "/v1/flights"}
+
+	flight, err := p.GetFlightStatus(context.Background(), "UAL2189")
+	if err != nil {
+		t.Fatalf("GetFlightStatus returned error: %v", err)
+	}
+	if flight.FlightNumber != "UA2189" || flight.Departure != "EWR" || flight.Arrival != "SFO" {
+		t.Fatalf("unexpected flight: %#v", flight)
+	}
+
+	flights, err := p.SearchFlights(context.Background(), "jfk", "lhr")
+	if err != nil {
+		t.Fatalf("SearchFlights returned error: %v", err)
+	}
+	if len(flights) != 1 || flights[0].FlightNumber != "AA100" {
+		t.Fatalf("unexpected search result: %#v", flights)
+	}
+
+	if _, err := p.GetAirportFlights(context.Background(), "XXX", "departures"); !errors.Is(err, provider.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestDatedLookupsAgainstFakeServer(t *testing.T) {
+	s, err := New("")
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	p := &provider.AviationStackProvider{APIKey: "test", Endpoint: server.URL + "/v1/flights"}
+	onFixtureDay := provider.Options{Date: time.Date(2026, 3, 13, 0, 0, 0, 0, time.UTC)}
+	dayBefore := provider.Options{Date: time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC)}
+
+	if _, err := p.GetFlightStatusWithOptions(context.Background(), "UA2189", onFixtureDay); err != nil {
+		t.Fatalf("expected the fixture's own date to find UA2189, got %v", err)
+	}
+	if _, err := p.GetFlightStatusWithOptions(context.Background(), "UA2189", dayBefore); !errors.Is(err, provider.ErrNotFound) {
+		t.Fatalf("expected no flight on a date without fixtures, got %v", err)
+	}
+	if _, err := p.SearchFlightsWithOptions(context.Background(), "JFK", "LHR", dayBefore); !errors.Is(err, provider.ErrNotFound) {
+		t.Fatalf("expected no route results on a date without fixtures, got %v", err)
+	}
+}
+
+func TestServerServesFutureSchedules(t *testing.T) {
+	s, err := New("")
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{"iataCode=JFK&type=departure&date=2026-12-20", 3},
+		{"iataCode=jfk&type=arrival&date=2026-12-20", 1},
+		{"iataCode=XXX&type=departure&date=2026-12-20", 0},
+	}
+	for _, tt := range tests {
+		rec, body := get(t, s, "/v1/flightsFuture?access_key=test&"+tt.query)
+		if rec.Code != http.StatusOK || len(body.Data) != tt.want {
+			t.Fatalf("%s: expected %d rows, got status %d and %d rows", tt.query, tt.want, rec.Code, len(body.Data))
+		}
+	}
+	for _, query := range []string{"type=departure&date=2026-12-20", "iataCode=JFK&date=2026-12-20", "iataCode=JFK&type=departure"} {
+		if rec, _ := get(t, s, "/v1/flightsFuture?access_key=test&"+query); rec.Code != http.StatusUnprocessableEntity {
+			t.Errorf("%s: expected 422, got %d", query, rec.Code)
+		}
+	}
+}
+
+func TestScheduleAgainstFakeServer(t *testing.T) {
+	s, err := New("")
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	p := &provider.AviationStackProvider{APIKey: "test", Endpoint: server.URL + "/v1/flights"}
+	date := time.Date(2026, 12, 20, 0, 0, 0, 0, time.Local)
+	flights, err := p.GetSchedule(context.Background(), "JFK", "LAX", date, provider.Page{})
+	if err != nil {
+		t.Fatalf("GetSchedule returned error: %v", err)
+	}
+	if len(flights) != 2 || flights[0].FlightNumber != "AA1" || flights[1].OperatingFlightNumber != "DL915" {
+		t.Fatalf("unexpected schedule: %#v", flights)
+	}
+}
+
+func TestSlowResponseStopsWhenClientGivesUp(t *testing.T) {
+	s := &Server{Faults: Faults{Delay: time.Minute}}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/flights?access_key=test", nil).WithContext(ctx)
+	done := make(chan struct{})
+	go func() {
+		s.ServeHTTP(rec, req)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected slow response to stop when the request was canceled")
+	}
+}
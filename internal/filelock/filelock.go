@@ -0,0 +1,48 @@
+// Package filelock serializes access to files shared by several flightcli
+// processes, such as the rate limit bucket and the usage ledger.
+package filelock
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+)
+
+const (
+	// retry is how often a process polls for a lock held by another.
+	retry = 10 * time.Millisecond
+	// stale is how old a lock file must be before it is assumed to belong
+	// to a process that died while holding it. Locks are only ever held
+	// for a read and a write of a small file.
+	stale = 5 * time.Second
+)
+
+// Acquire locks path by creating path+".lock" exclusively, which works the
+// same on every platform, and returns a func that releases the lock. It
+// waits while another process holds the lock, until ctx is done.
+func Acquire(ctx context.Context, path string) (func(), error) {
+	lockPath := path + ".lock"
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(lockPath) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, err
+		}
+		if info, err := os.Stat(lockPath); err == nil && time.Since(info.ModTime()) > stale {
+			os.Remove(lockPath)
+			continue
+		}
+
+		timer := time.NewTimer(retry)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package filelock
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAcquireExcludesOtherHolders(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	var mu sync.Mutex
+	holders, most := 0, 0
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock, err := Acquire(context.Background(), path)
+			if err != nil {
+				t.Errorf("Acquire returned error: %v", err)
+				return
+			}
+			mu.Lock()
+			holders++
+			most = max(most, holders)
+			mu.Unlock()
+			time.Sleep(time.Millisecond)
+			mu.Lock()
+			holders--
+			mu.Unlock()
+			unlock()
+		}()
+	}
+	wg.Wait()
+
+	if most != 1 {
+		t.Fatalf("expected one holder at a time, saw %d", most)
+	}
+	if _, err := os.Stat(path + ".lock"); !os.IsNotExist(err) {
+		t.Fatalf("expected the lock file to be removed, got %v", err)
+	}
+}
+
+func TestAcquireGivesUpWhenContextEnds(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	unlock, err := Acquire(context.Background(), path)
+	if err != nil {
+		t.Fatalf("Acquire returned error: %v", err)
+	}
+	defer unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	if _, err := Acquire(ctx, path); err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded while the lock is held, got %v", err)
+	}
+}
@@ -8,12 +8,38 @@ type Flight struct {
 	Departure     string    `json:"departure"`
 	Arrival       string    `json:"arrival"`
 	Status        string    `json:"status"`
+	FlightDate    string    `json:"flight_date,omitempty"`
 	Altitude      float64   `json:"altitude"`
 	Speed         float64   `json:"speed"`
 	Latitude      float64   `json:"latitude"`
 	Longitude     float64   `json:"longitude"`
+	Heading       float64   `json:"heading,omitempty"`
 	DepartureTime time.Time `json:"departure_time,omitempty"`
 	ArrivalTime   time.Time `json:"arrival_time,omitempty"`
+	Source        string    `json:"source,omitempty"`
+
+	DepartureDetails LegDetails `json:"departure_details,omitzero"`
+	ArrivalDetails   LegDetails `json:"arrival_details,omitzero"`
+	Aircraft         Aircraft   `json:"aircraft,omitzero"`
+	// Codeshares lists other airlines' numbers for this flight, such as
+	// the codeshare number it was looked up by.
+	Codeshares []string `json:"codeshares,omitempty"`
+
+	// VerticalRate is in feet per minute, positive when climbing.
+	VerticalRate float64 `json:"vertical_rate,omitempty"`
+	OnGround     bool    `json:"on_ground,omitempty"`
+	// LiveUpdated is when the position was last reported, if known.
+	LiveUpdated time.Time `json:"live_updated,omitzero"`
+}
+
+// LiveStaleAfter is how old a live position may be before it is flagged
+// as stale.
+const LiveStaleAfter = 5 * time.Minute
+
+// LiveStale reports whether the live position is older than
+// LiveStaleAfter at now. Positions without a timestamp are never stale.
+func (f *Flight) LiveStale(now time.Time) bool {
+	return !f.LiveUpdated.IsZero() && now.Sub(f.LiveUpdated) > LiveStaleAfter
 }
 
 type AirportFlight struct {
@@ -29,4 +55,149 @@ type AirportFlight struct {
 	DepartureTime time.Time `json:"departure_time,omitempty"`
 	ArrivalTime   time.Time `json:"arrival_time,omitempty"`
 	ScheduledTime time.Time `json:"scheduled_time,omitempty"`
+	Source        string    `json:"source,omitempty"`
+
+	// OperatingFlightNumber and OperatingAirline name the flight that
+	// carries this codeshare, as for ScheduledFlight. Codeshares lists
+	// the codeshare numbers folded into this row by CollapseCodeshares.
+	OperatingFlightNumber string   `json:"operating_flight_number,omitempty"`
+	OperatingAirline      string   `json:"operating_airline,omitempty"`
+	Codeshares            []string `json:"codeshares,omitempty"`
+
+	DepartureDetails LegDetails `json:"departure_details,omitzero"`
+	ArrivalDetails   LegDetails `json:"arrival_details,omitzero"`
+	Aircraft         Aircraft   `json:"aircraft,omitzero"`
+}
+
+// Codeshare reports whether another airline operates the flight.
+func (f AirportFlight) Codeshare() bool {
+	return f.OperatingFlightNumber != ""
+}
+
+// CollapseCodeshares folds codeshare rows into the row of the flight that
+// operates them, so each physical flight appears once with its codeshare
+// numbers in Codeshares. A codeshare whose operating flight is missing
+// from flights stands in for it. Order follows each flight's first row.
+func CollapseCodeshares(flights []AirportFlight) []AirportFlight {
+	type key struct {
+		number    string
+		scheduled int64
+	}
+	index := make(map[key]int, len(flights))
+	standIns := make(map[int]bool)
+	collapsed := make([]AirportFlight, 0, len(flights))
+
+	for _, f := range flights {
+		if !f.Codeshare() {
+			k := key{f.FlightNumber, f.ScheduledTime.Unix()}
+			if i, ok := index[k]; ok && standIns[i] {
+				// A codeshare stood in for this flight; take the real row.
+				f.Codeshares = append(f.Codeshares, collapsed[i].Codeshares...)
+				collapsed[i] = f
+				delete(standIns, i)
+				continue
+			}
+			index[k] = len(collapsed)
+			collapsed = append(collapsed, f)
+			continue
+		}
+
+		k := key{f.OperatingFlightNumber, f.ScheduledTime.Unix()}
+		if i, ok := index[k]; ok {
+			collapsed[i].Codeshares = append(collapsed[i].Codeshares, f.FlightNumber)
+			continue
+		}
+		operating := f
+		operating.FlightNumber = f.OperatingFlightNumber
+		operating.Airline = f.OperatingAirline
+		operating.OperatingFlightNumber = ""
+		operating.OperatingAirline = ""
+		operating.Codeshares = []string{f.FlightNumber}
+		index[k] = len(collapsed)
+		standIns[len(collapsed)] = true
+		collapsed = append(collapsed, operating)
+	}
+	return collapsed
+}
+
+// Aircraft identifies the airframe flying a flight. Types are designators
+// such as ICAO "B738" and IATA "738"; see the aircraft package for names.
+type Aircraft struct {
+	Registration string `json:"registration,omitempty"`
+	ICAOType     string `json:"icao_type,omitempty"`
+	IATAType     string `json:"iata_type,omitempty"`
+	// ICAO24 is the transponder's 24-bit address in hex, e.g. "A0F1BB".
+	ICAO24 string `json:"icao24,omitempty"`
+}
+
+// LegDetails is what a schedule provider knows about one end of a flight
+// beyond its airport code. Live feeds leave it empty.
+type LegDetails struct {
+	Airport  string `json:"airport,omitempty"`
+	Terminal string `json:"terminal,omitempty"`
+	Gate     string `json:"gate,omitempty"`
+	Baggage  string `json:"baggage,omitempty"`
+	// Delay is the delay in minutes as reported by the provider.
+	Delay     int       `json:"delay,omitempty"`
+	Scheduled time.Time `json:"scheduled,omitzero"`
+	Estimated time.Time `json:"estimated,omitzero"`
+	Actual    time.Time `json:"actual,omitzero"`
+}
+
+// LateThreshold is how far behind schedule a leg must run to count as
+// late, following the usual airline on-time definition.
+const LateThreshold = 15 * time.Minute
+
+// ComputedDelay is how far the leg runs behind schedule: the actual time,
+// or else the estimated time, against the scheduled one. Without both, it
+// falls back to the provider's reported Delay. ok is false when neither is
+// known.
+func (d LegDetails) ComputedDelay() (delay time.Duration, ok bool) {
+	latest := d.Actual
+	if latest.IsZero() {
+		latest = d.Estimated
+	}
+	if !d.Scheduled.IsZero() && !latest.IsZero() {
+		return latest.Sub(d.Scheduled), true
+	}
+	if d.Delay != 0 {
+		return time.Duration(d.Delay) * time.Minute, true
+	}
+	return 0, false
+}
+
+// Late reports whether the departure, or for arrivals boards the arrival,
+// runs at least LateThreshold behind schedule.
+func (f AirportFlight) Late(arrivals bool) bool {
+	leg := f.DepartureDetails
+	if arrivals {
+		leg = f.ArrivalDetails
+	}
+	delay, ok := leg.ComputedDelay()
+	return ok && delay >= LateThreshold
+}
+
+// ScheduledFlight is one timetable entry for a future date. Times are
+// local to each airport, as "15:04".
+type ScheduledFlight struct {
+	FlightNumber string `json:"flight_number"`
+	Airline      string `json:"airline"`
+	// OperatingFlightNumber and OperatingAirline name the carrier that
+	// flies the aircraft when FlightNumber is a codeshare sold by Airline.
+	// They are empty when Airline operates the flight itself.
+	OperatingFlightNumber string `json:"operating_flight_number,omitempty"`
+	OperatingAirline      string `json:"operating_airline,omitempty"`
+	Origin                string `json:"origin"`
+	Destination           string `json:"destination"`
+	Date                  string `json:"date"`
+	DepartureTime         string `json:"departure_time"`
+	ArrivalTime           string `json:"arrival_time"`
+	// ArrivalDayOffset is how many days after Date the flight lands.
+	ArrivalDayOffset int    `json:"arrival_day_offset,omitempty"`
+	Source           string `json:"source,omitempty"`
+}
+
+// Codeshare reports whether another airline operates the flight.
+func (f ScheduledFlight) Codeshare() bool {
+	return f.OperatingFlightNumber != ""
 }
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCollapseCodeshares(t *testing.T) {
+	at := time.Date(2026, time.March, 13, 18, 0, 0, 0, time.UTC)
+	later := at.Add(time.Hour)
+	flights := []AirportFlight{
+		{FlightNumber: "IB4218", Airline: "Iberia", OperatingFlightNumber: "AA100", OperatingAirline: "American Airlines", ScheduledTime: at},
+		{FlightNumber: "DL1", Airline: "Delta Air Lines", ScheduledTime: at},
+		{FlightNumber: "AA100", Airline: "American Airlines", Status: "Scheduled", ScheduledTime: at},
+		{FlightNumber: "BA1511", Airline: "British Airways", OperatingFlightNumber: "AA100", OperatingAirline: "American Airlines", ScheduledTime: at},
+		{FlightNumber: "AF22", Airline: "Air France", OperatingFlightNumber: "DL1", OperatingAirline: "Delta Air Lines", ScheduledTime: later},
+		{FlightNumber: "KL6", Airline: "KLM", OperatingFlightNumber: "DL1", OperatingAirline: "Delta Air Lines", ScheduledTime: later},
+	}
+
+	collapsed := CollapseCodeshares(flights)
+
+	var got []string
+	for _, f := range collapsed {
+		got = append(got, f.FlightNumber)
+	}
+	// DL1 at 19:00 is a different departure from DL1 at 18:00.
+	if want := []string{"AA100", "DL1", "DL1"}; !slices.Equal(got, want) {
+		t.Fatalf("expected rows %v, got %v", want, got)
+	}
+
+	aa := collapsed[0]
+	if aa.Status != "Scheduled" || aa.Codeshare() || !slices.Equal(aa.Codeshares, []string{"IB4218", "BA1511"}) {
+		t.Fatalf("expected the real AA100 row with both codeshares, got %#v", aa)
+	}
+	if standIn := collapsed[2]; standIn.Airline != "Delta Air Lines" || standIn.Codeshare() || !slices.Equal(standIn.Codeshares, []string{"AF22", "KL6"}) {
+		t.Fatalf("expected a DL1 row standing in for the missing operating flight, got %#v", standIn)
+	}
+	if len(collapsed[1].Codeshares) != 0 {
+		t.Fatalf("expected the 18:00 DL1 to have no codeshares, got %q", collapsed[1].Codeshares)
+	}
+}
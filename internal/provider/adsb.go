@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/models"
+)
+
+const knotsToMph = 1.15078
+
+// DefaultADSBSource is where dump1090-fa writes aircraft.json on most installs.
+const DefaultADSBSource = "/run/dump1090-fa/aircraft.json"
+
+// ADSBProvider answers flight status lookups from a local dump1090/readsb
+// receiver's aircraft.json. A receiver only knows what it can hear, so
+// airport boards and route search are not supported.
+type ADSBProvider struct {
+	// Source is a file path, a directory containing aircraft.json, or an
+	// http(s) URL serving it. Defaults to DefaultADSBSource.
+	Source string
+	// Transport, when set, sends requests for an http(s) Source instead of
+	// the shared client's transport.
+	Transport http.RoundTripper
+}
+
+type adsbResponse struct {
+	Now      float64        `json:"now"`
+	Aircraft []adsbAircraft `json:"aircraft"`
+}
+
+type adsbAircraft struct {
+	Hex     string          `json:"hex"`
+	Flight  string          `json:"flight"`
+	Lat     *float64        `json:"lat"`
+	Lon     *float64        `json:"lon"`
+	AltBaro json.RawMessage `json:"alt_baro"`
+	GS      *float64        `json:"gs"`
+	Track   *float64        `json:"track"`
+	Seen    float64         `json:"seen"`
+	// BaroRate is the barometric vertical rate in feet per minute.
+	BaroRate *float64 `json:"baro_rate"`
+
+	// Registration and Type are filled in by readsb and tar1090 when
+	// they have an aircraft database.
+	Registration string `json:"r"`
+	Type         string `json:"t"`
+}
+
+func (a *ADSBProvider) GetFlightStatus(ctx context.Context, flightNumber string) (*models.Flight, error) {
+	normalizedFlightNumber := normalizeFlightNumber(strings.ToUpper(strings.TrimSpace(flightNumber)))
+
+	data, err := a.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, candidate := range callsignCandidates(normalizedFlightNumber) {
+		for _, aircraft := range data.Aircraft {
+			if strings.ToUpper(strings.TrimSpace(aircraft.Flight)) == candidate {
+				return flightFromADSB(aircraft, data.Now), nil
+			}
+		}
+	}
+
+	return nil, notFoundf("no flight found for %s", normalizedFlightNumber)
+}
+
+func (a *ADSBProvider) GetAirportFlights(ctx context.Context, airportCode string, flightType string) ([]models.AirportFlight, error) {
+	return nil, fmt.Errorf("airport boards are not available from a local ADS-B receiver: %w", ErrNotSupported)
+}
+
+func (a *ADSBProvider) SearchFlights(ctx context.Context, from, to string) ([]models.AirportFlight, error) {
+	return nil, fmt.Errorf("route search is not available from a local ADS-B receiver: %w", ErrNotSupported)
+}
+
+func (a *ADSBProvider) load(ctx context.Context) (*adsbResponse, error) {
+	source := strings.TrimSpace(a.Source)
+	if source == "" {
+		source = DefaultADSBSource
+	}
+
+	var body io.ReadCloser
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+		if err != nil {
+			return nil, fmt.Errorf("building ADS-B request: %w", err)
+		}
+		resp, err := httpClient(a.Transport).Do(req)
+		if err != nil {
+			return nil, redactedErrorf(err, "failed to reach ADS-B receiver: %s", sanitizedProviderErrorText(err.Error()))
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("ADS-B receiver returned status %d", resp.StatusCode)
+		}
+		body = resp.Body
+	} else {
+		if info, err := os.Stat(source); err == nil && info.IsDir() {
+			source = filepath.Join(source, "aircraft.json")
+		}
+		f, err := os.Open(source)
+		if err != nil {
+			return nil, fmt.Errorf("opening ADS-B data: %w", err)
+		}
+		body = f
+	}
+	defer body.Close()
+
+	var data adsbResponse
+	if err := json.NewDecoder(io.LimitReader(body, 10<<20)).Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to parse aircraft.json: %w", err)
+	}
+	return &data, nil
+}
+
+// flightFromADSB maps one aircraft; now is the file's own timestamp in
+// Unix seconds, which with the aircraft's seen age dates its position.
+func flightFromADSB(aircraft adsbAircraft, now float64) *models.Flight {
+	flightNumber, airline := flightFromCallsign(aircraft.Flight)
+
+	// alt_baro is a number of feet, or the string "ground".
+	status := "In Flight"
+	var altitude float64
+	var onGround bool
+	if err := json.Unmarshal(aircraft.AltBaro, &altitude); err != nil {
+		var ground string
+		if json.Unmarshal(aircraft.AltBaro, &ground) == nil && ground == "ground" {
+			status = "On Ground"
+			onGround = true
+		}
+	}
+
+	flight := &models.Flight{
+		FlightNumber: flightNumber,
+		Airline:      airline,
+		Status:       status,
+		Altitude:     altitude,
+		Aircraft: models.Aircraft{
+			Registration: strings.ToUpper(strings.TrimSpace(aircraft.Registration)),
+			ICAOType:     strings.ToUpper(strings.TrimSpace(aircraft.Type)),
+			ICAO24:       strings.ToUpper(strings.TrimPrefix(strings.TrimSpace(aircraft.Hex), "~")),
+		},
+		OnGround: onGround,
+	}
+	if now > 0 {
+		flight.LiveUpdated = time.UnixMilli(int64(math.Round((now - aircraft.Seen) * 1000)))
+	}
+	if aircraft.Lat != nil && aircraft.Lon != nil {
+		flight.Latitude = *aircraft.Lat
+		flight.Longitude = *aircraft.Lon
+	}
+	if aircraft.GS != nil {
+		flight.Speed = *aircraft.GS * knotsToMph
+	}
+	if aircraft.Track != nil {
+		flight.Heading = *aircraft.Track
+	}
+	if aircraft.BaroRate != nil {
+		flight.VerticalRate = *aircraft.BaroRate
+	}
+	return flight
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/models"
+)
+
+const testAircraftJSON = `{"now":1773400000.1,"messages":1200,"aircraft":[
+	{"hex":"a1b2c3","flight":"UAL2189 ","r":"N37502","t":"B38M","lat":40.7128,"lon":-73.9352,"alt_baro":35000,"baro_rate":1792,"gs":450,"track":274.5,"seen":0.4},
+	{"hex":"abc123","flight":"DAL200  ","alt_baro":"ground","gs":12,"seen":1.2},
+	{"hex":"def456","alt_baro":12000,"seen":3.0}
+]}`
+
+func writeTestAircraftJSON(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "aircraft.json"), []byte(testAircraftJSON), 0600); err != nil {
+		t.Fatalf("write aircraft.json: %v", err)
+	}
+	return dir
+}
+
+func TestADSBGetFlightStatusFromFile(t *testing.T) {
+	dir := writeTestAircraftJSON(t)
+	provider := &ADSBProvider{Source: filepath.Join(dir, "aircraft.json")}
+
+	flight, err := provider.GetFlightStatus(context.Background(), "UA2189")
+	if err != nil {
+		t.Fatalf("GetFlightStatus returned error: %v", err)
+	}
+	if flight.FlightNumber != "UA2189" || flight.Airline != "United Airlines" {
+		t.Fatalf("expected callsign mapped through airlines table, got %#v", flight)
+	}
+	if flight.Status != "In Flight" || flight.Altitude != 35000 {
+		t.Fatalf("unexpected status/altitude: %#v", flight)
+	}
+	if flight.Latitude != 40.7128 || flight.Longitude != -73.9352 || flight.Heading != 274.5 {
+		t.Fatalf("unexpected position: %#v", flight)
+	}
+	if flight.Speed < 517 || flight.Speed > 518 {
+		t.Fatalf("expected ground speed converted to mph, got %v", flight.Speed)
+	}
+	if want := (models.Aircraft{Registration: "N37502", ICAOType: "B38M", ICAO24: "A1B2C3"}); flight.Aircraft != want {
+		t.Fatalf("expected aircraft %#v, got %#v", want, flight.Aircraft)
+	}
+	if flight.VerticalRate != 1792 || flight.OnGround {
+		t.Fatalf("unexpected vertical state: %#v", flight)
+	}
+	if want := time.UnixMilli(1773399999700); !flight.LiveUpdated.Equal(want) {
+		t.Fatalf("expected position dated %v, got %v", want, flight.LiveUpdated)
+	}
+}
+
+func TestADSBGetFlightStatusAcceptsDirectoryAndGround(t *testing.T) {
+	provider := &ADSBProvider{Source: writeTestAircraftJSON(t)}
+
+	flight, err := provider.GetFlightStatus(context.Background(), "DAL200")
+	if err != nil {
+		t.Fatalf("GetFlightStatus returned error: %v", err)
+	}
+	if flight.FlightNumber != "DL200" || flight.Status != "On Ground" {
+		t.Fatalf("expected on-ground DL200, got %#v", flight)
+	}
+}
+
+func TestADSBGetFlightStatusFromHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, testAircraftJSON)
+	}))
+	t.Cleanup(server.Close)
+	provider := &ADSBProvider{Source: server.URL + "/data/aircraft.json"}
+
+	if _, err := provider.GetFlightStatus(context.Background(), "UAL2189"); err != nil {
+		t.Fatalf("GetFlightStatus returned error: %v", err)
+	}
+	if _, err := provider.GetFlightStatus(context.Background(), "AA100"); err == nil {
+		t.Fatal("expected unheard flight to return an error")
+	}
+}
+
+func TestADSBBoardsAreNotSupported(t *testing.T) {
+	provider := &ADSBProvider{Source: writeTestAircraftJSON(t)}
+
+	if _, err := provider.GetAirportFlights(context.Background(), "JFK", "departures"); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("expected ErrNotSupported for airport board, got %v", err)
+	}
+	if _, err := provider.SearchFlights(context.Background(), "JFK", "LAX"); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("expected ErrNotSupported for route search, got %v", err)
+	}
+}
@@ -3,42 +3,120 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log"
+	"net"
 	"net/http"
 	"net/url"
+	"path"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/joshuachuah/flightcli/internal/airlines"
 	"github.com/joshuachuah/flightcli/internal/models"
+	"github.com/joshuachuah/flightcli/internal/quota"
+	"github.com/joshuachuah/flightcli/internal/ratelimit"
 	"github.com/joshuachuah/flightcli/internal/sanitize"
 )
 
-const aviationStackEndpoint = "https://api.aviationstack.com/v1/flights"
+// DefaultAviationStackEndpoint is the public AviationStack flights API.
+const DefaultAviationStackEndpoint = "https://api.aviationstack.com/v1/flights"
+
+// AviationStackMaxPageSize is the most flights AviationStack returns per
+// request, which is also its default.
+const AviationStackMaxPageSize = 100
+
+// AviationStack endpoints, relative to the directory of the configured
+// flights endpoint.
+const (
+	flightsResource  = "flights"
+	scheduleResource = "flightsFuture"
+)
+
+const (
+	defaultPageSize = AviationStackMaxPageSize
+	// maxPageRequests caps the pages one lookup may request, since each
+	// counts against the plan's monthly allowance.
+	maxPageRequests = 10
+)
 
 var accessKeyQueryPattern = regexp.MustCompile(`(access_key=)[^&\s"]*`)
 
 const (
-	metersToFeet = 3.28084
-	kmhToMph     = 0.621371
+	metersToFeet       = 3.28084
+	kmhToMph           = 0.621371
+	kmhToFeetPerMinute = 54.6807
 )
 
 type AviationStackProvider struct {
 	APIKey string
+	// Endpoint is the /v1/flights URL, e.g. the free tier's
+	// http://api.aviationstack.com/v1/flights or a local `flightcli dev
+	// fake-server`. Defaults to DefaultAviationStackEndpoint.
+	Endpoint string
+	// Timeout bounds each request. Defaults to 15 seconds.
+	Timeout time.Duration
+	// Transport, when set, sends requests instead of the shared client's
+	// transport, e.g. to record or replay them. It cannot be combined with
+	// CAFile or ProxyURL.
+	Transport http.RoundTripper
+	// CAFile is a PEM bundle trusted in addition to the system roots, e.g.
+	// for a TLS-inspecting corporate proxy.
+	CAFile string
+	// ProxyURL sends requests through an http, https or socks5 proxy
+	// instead of the one from HTTPS_PROXY/HTTP_PROXY.
+	ProxyURL string
+
+	// MaxRetries is how many times a request is retried after a network
+	// error, a 5xx or a 429. Zero disables retries.
+	MaxRetries int
+	// RetryBackoff is the first retry's delay; each retry doubles it, with
+	// jitter, up to 30 seconds. Defaults to 500ms. A 429's Retry-After
+	// header overrides it.
+	RetryBackoff time.Duration
+	// Logger, when set, receives debug messages such as retries.
+	Logger *log.Logger
+	// Quota, when set, records every request (retries included) and may
+	// refuse one that would exceed the monthly budget.
+	Quota *quota.Budget
+	// RateLimiter, when set, paces requests (retries included), blocking
+	// until one may be sent.
+	RateLimiter *ratelimit.Limiter
+
+	transportOnce sync.Once
+	transport     *http.Transport
+	transportErr  error
+}
+
+// aviationStackResponse is the envelope shared by AviationStack endpoints;
+// T is the type of one data row.
+type aviationStackResponse[T any] struct {
+	Pagination *aviationStackPagination `json:"pagination"`
+	Data       []T                      `json:"data"`
+	Error      *aviationStackError      `json:"error"`
 }
 
-type aviationStackResponse struct {
-	Data []aviationStackFlight `json:"data"`
+type aviationStackPagination struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+	Count  int `json:"count"`
+	Total  int `json:"total"`
 }
 
 type aviationStackFlight struct {
+	FlightDate   string               `json:"flight_date"`
 	FlightStatus string               `json:"flight_status"`
 	Departure    aviationStackAirport `json:"departure"`
 	Arrival      aviationStackAirport `json:"arrival"`
 	Airline      aviationStackAirline `json:"airline"`
 	Flight       aviationStackInfo    `json:"flight"`
+	Aircraft     *aviationStackPlane  `json:"aircraft"`
 	Live         *aviationStackLive   `json:"live"`
 }
 
@@ -46,6 +124,10 @@ type aviationStackAirport struct {
 	Airport   string `json:"airport"`
 	IATA      string `json:"iata"`
 	Timezone  string `json:"timezone"`
+	Terminal  string `json:"terminal"`
+	Gate      string `json:"gate"`
+	Baggage   string `json:"baggage"`
+	Delay     *int   `json:"delay"`
 	Scheduled string `json:"scheduled"`
 	Estimated string `json:"estimated"`
 	Actual    string `json:"actual"`
@@ -57,25 +139,64 @@ type aviationStackAirline struct {
 	ICAO string `json:"icao"`
 }
 
+type aviationStackPlane struct {
+	Registration string `json:"registration"`
+	IATA         string `json:"iata"`
+	ICAO         string `json:"icao"`
+	ICAO24       string `json:"icao24"`
+}
+
 type aviationStackInfo struct {
 	IATA string `json:"iata"`
+	// Codeshared is set when this row is a codeshare, and names the
+	// flight that operates it.
+	Codeshared *aviationStackCodeshare `json:"codeshared"`
+}
+
+// aviationStackCodeshare is the operating flight behind a codeshare row.
+// Codes come back in lower case.
+type aviationStackCodeshare struct {
+	AirlineName string `json:"airline_name"`
+	AirlineIATA string `json:"airline_iata"`
+	AirlineICAO string `json:"airline_icao"`
+	FlightIATA  string `json:"flight_iata"`
+	FlightICAO  string `json:"flight_icao"`
+}
+
+// operatingFlight returns the flight number and airline that operate a
+// codeshare, or ok false when f is not a codeshare.
+func (f aviationStackFlight) operatingFlight() (number, airline string, ok bool) {
+	c := f.Flight.Codeshared
+	if c == nil || c.FlightIATA == "" {
+		return "", "", false
+	}
+	return strings.ToUpper(c.FlightIATA), airlineName(c.AirlineICAO, c.AirlineName), true
 }
 
 type aviationStackLive struct {
+	Updated         string  `json:"updated"`
 	Latitude        float64 `json:"latitude"`
 	Longitude       float64 `json:"longitude"`
 	Altitude        float64 `json:"altitude"`
+	Direction       float64 `json:"direction"`
 	SpeedHorizontal float64 `json:"speed_horizontal"`
+	SpeedVertical   float64 `json:"speed_vertical"`
 	IsGround        bool    `json:"is_ground"`
 }
 
 func (a *AviationStackProvider) GetFlightStatus(ctx context.Context, flightNumber string) (*models.Flight, error) {
+	return a.GetFlightStatusWithOptions(ctx, flightNumber, Options{})
+}
+
+// GetFlightStatusWithOptions is GetFlightStatus on opts.Date, if set.
+func (a *AviationStackProvider) GetFlightStatusWithOptions(ctx context.Context, flightNumber string, opts Options) (*models.Flight, error) {
 	normalizedFlightNumber := normalizeFlightNumber(strings.ToUpper(strings.TrimSpace(flightNumber)))
 	queries := flightNumberQueries(normalizedFlightNumber)
-	notFoundErr := fmt.Errorf("no flight found for %s", normalizedFlightNumber)
+	notFoundErr := notFoundf("no flight found for %s%s", normalizedFlightNumber, onDate(opts.Date))
 
 	var data []aviationStackFlight
 	for _, query := range queries {
+		setFlightDate(query.params, opts.Date)
 		flights, err := a.fetchFlights(ctx, query.params)
 		if err != nil {
 			return nil, err
@@ -104,14 +225,30 @@ func (a *AviationStackProvider) GetFlightStatus(ctx context.Context, flightNumbe
 		displayNumber = normalizedFlightNumber
 	}
 
+	// A codeshare row repeats the operating flight's times and position,
+	// so report it as the operating flight that was looked up by another
+	// airline's number.
+	airline := f.Airline.Name
+	var codeshares []string
+	if number, operator, ok := f.operatingFlight(); ok {
+		codeshares = []string{displayNumber}
+		displayNumber, airline = number, operator
+	}
+
 	flight := &models.Flight{
 		FlightNumber:  displayNumber,
-		Airline:       f.Airline.Name,
+		Airline:       airline,
 		Departure:     f.Departure.IATA,
 		Arrival:       f.Arrival.IATA,
 		Status:        formatStatus(status),
+		FlightDate:    f.FlightDate,
+		Codeshares:    codeshares,
 		DepartureTime: departureTime,
 		ArrivalTime:   arrivalTime,
+
+		DepartureDetails: legDetailsFromAviationStack(f.Departure),
+		ArrivalDetails:   legDetailsFromAviationStack(f.Arrival),
+		Aircraft:         aircraftFromAviationStack(f.Aircraft),
 	}
 
 	if f.Live != nil {
@@ -119,12 +256,25 @@ func (a *AviationStackProvider) GetFlightStatus(ctx context.Context, flightNumbe
 		flight.Longitude = f.Live.Longitude
 		flight.Altitude = f.Live.Altitude * metersToFeet
 		flight.Speed = f.Live.SpeedHorizontal * kmhToMph
+		flight.Heading = f.Live.Direction
+		flight.VerticalRate = f.Live.SpeedVertical * kmhToFeetPerMinute
+		flight.OnGround = f.Live.IsGround
+		// Unlike schedule times, live timestamps are real UTC.
+		if updated, err := time.Parse(time.RFC3339, f.Live.Updated); err == nil {
+			flight.LiveUpdated = updated
+		}
 	}
 
 	return flight, nil
 }
 
 func (a *AviationStackProvider) GetAirportFlights(ctx context.Context, airportCode string, flightType string) ([]models.AirportFlight, error) {
+	return a.GetAirportFlightsWithOptions(ctx, airportCode, flightType, Options{})
+}
+
+// GetAirportFlightsWithOptions is GetAirportFlights on opts.Date, if set,
+// for the flights opts.Page selects.
+func (a *AviationStackProvider) GetAirportFlightsWithOptions(ctx context.Context, airportCode string, flightType string, opts Options) ([]models.AirportFlight, error) {
 	code := strings.ToUpper(strings.TrimSpace(airportCode))
 	flightType = strings.ToLower(strings.TrimSpace(flightType))
 
@@ -135,41 +285,51 @@ func (a *AviationStackProvider) GetAirportFlights(ctx context.Context, airportCo
 		return nil, fmt.Errorf("invalid flight type %q: must be 'departures' or 'arrivals'", flightType)
 	}
 
-	data, err := a.fetchFlights(ctx, url.Values{param: []string{code}})
+	scheduled := func(f aviationStackFlight) time.Time {
+		if flightType == "arrivals" {
+			return parseLocalTime(f.Arrival.Timezone, f.Arrival.Scheduled)
+		}
+		return parseLocalTime(f.Departure.Timezone, f.Departure.Scheduled)
+	}
+	params := url.Values{param: []string{code}}
+	setFlightDate(params, opts.Date)
+	data, err := fetchPages(ctx, a, flightsResource, params, opts.Page, scheduled)
 	if err != nil {
 		return nil, err
 	}
 	if len(data) == 0 {
-		return nil, fmt.Errorf("no flights found for airport %s", code)
+		return nil, notFoundf("no flights found for airport %s%s", code, onDate(opts.Date))
 	}
 
 	flights := make([]models.AirportFlight, 0, len(data))
 	for _, f := range data {
-		scheduled := f.Departure.Scheduled
-		tz := f.Departure.Timezone
-		if flightType == "arrivals" {
-			scheduled = f.Arrival.Scheduled
-			tz = f.Arrival.Timezone
-		}
-		flights = append(flights, airportFlightFromAviationStack(f, parseLocalTime(tz, scheduled)))
+		flights = append(flights, airportFlightFromAviationStack(f, scheduled(f)))
 	}
 
 	return flights, nil
 }
 
 func (a *AviationStackProvider) SearchFlights(ctx context.Context, from, to string) ([]models.AirportFlight, error) {
+	return a.SearchFlightsWithOptions(ctx, from, to, Options{})
+}
+
+// SearchFlightsWithOptions is SearchFlights on opts.Date, if set, for the
+// flights opts.Page selects.
+func (a *AviationStackProvider) SearchFlightsWithOptions(ctx context.Context, from, to string, opts Options) ([]models.AirportFlight, error) {
 	from = strings.ToUpper(strings.TrimSpace(from))
 	to = strings.ToUpper(strings.TrimSpace(to))
 
-	data, err := a.fetchFlights(ctx, url.Values{
+	params := url.Values{
 		"dep_iata": []string{from},
 		"arr_iata": []string{to},
-	})
+	}
+	setFlightDate(params, opts.Date)
+	data, err := fetchPages(ctx, a, flightsResource, params, opts.Page, departureScheduled)
 	if err != nil {
 		return nil, err
 	}
 	if len(data) == 0 {
-		return nil, fmt.Errorf("no flights found for route %s -> %s", from, to)
+		return nil, notFoundf("no flights found for route %s -> %s%s", from, to, onDate(opts.Date))
 	}
 
 	flights := make([]models.AirportFlight, 0, len(data))
@@ -180,9 +340,112 @@ func (a *AviationStackProvider) SearchFlights(ctx context.Context, from, to stri
 }
 
 func (a *AviationStackProvider) fetchFlights(ctx context.Context, params url.Values) ([]aviationStackFlight, error) {
-	endpoint, err := url.Parse(aviationStackEndpoint)
+	resp, err := fetchPage[aviationStackFlight](ctx, a, flightsResource, params)
 	if err != nil {
-		return nil, fmt.Errorf("invalid AviationStack endpoint: %w", err)
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// fetchPages requests the rows page selects from resource, following
+// AviationStack's limit/offset pagination for as long as page.Max or
+// page.Window asks and the results last, up to maxPageRequests. scheduled
+// gives the time a row is judged against page.Window.
+func fetchPages[T any](ctx context.Context, a *AviationStackProvider, resource string, params url.Values, page Page, scheduled func(T) time.Time) ([]T, error) {
+	size := page.Size
+	if size <= 0 {
+		size = defaultPageSize
+	}
+	offset := 0
+	if page.Number > 1 {
+		offset = (page.Number - 1) * size
+	}
+	var horizon time.Time
+	if page.Window > 0 {
+		horizon = time.Now().Add(page.Window)
+	}
+
+	var flights []T
+	for request := 1; ; request++ {
+		query := url.Values{}
+		for key, values := range params {
+			query[key] = values
+		}
+		if page.Size > 0 {
+			query.Set("limit", strconv.Itoa(page.Size))
+		}
+		if offset > 0 {
+			query.Set("offset", strconv.Itoa(offset))
+		}
+
+		resp, err := fetchPage[T](ctx, a, resource, query)
+		if err != nil {
+			return nil, err
+		}
+		flights = append(flights, resp.Data...)
+
+		more := resp.Pagination != nil && len(resp.Data) > 0
+		if more {
+			offset = resp.Pagination.Offset + len(resp.Data)
+			more = offset < resp.Pagination.Total
+		}
+		switch {
+		case page.Max == 0 && page.Window == 0:
+			more = false
+		case page.Max > 0 && len(flights) >= page.Max:
+			more = false
+		case page.Window > 0 && slices.ContainsFunc(resp.Data, func(f T) bool {
+			return scheduled(f).After(horizon)
+		}):
+			more = false
+		}
+		if !more {
+			break
+		}
+		if request == maxPageRequests {
+			if a.Logger != nil {
+				a.Logger.Printf("AviationStack: stopped after %d pages; more results remain", request)
+			}
+			break
+		}
+	}
+
+	if page.Window > 0 {
+		flights = slices.DeleteFunc(flights, func(f T) bool {
+			return scheduled(f).After(horizon)
+		})
+	}
+	if page.Max > 0 && len(flights) > page.Max {
+		flights = flights[:page.Max]
+	}
+	return flights, nil
+}
+
+// setFlightDate restricts an AviationStack query to date, if set.
+func setFlightDate(params url.Values, date time.Time) {
+	if !date.IsZero() {
+		params.Set("flight_date", date.Format(DateLayout))
+	}
+}
+
+// onDate is " on YYYY-MM-DD" for messages about a dated lookup.
+func onDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+	return " on " + date.Format(DateLayout)
+}
+
+func departureScheduled(f aviationStackFlight) time.Time {
+	return parseLocalTime(f.Departure.Timezone, f.Departure.Scheduled)
+}
+
+// fetchPage makes one request to resource, such as flightsResource, with
+// retries.
+func fetchPage[T any](ctx context.Context, a *AviationStackProvider, resource string, params url.Values) (*aviationStackResponse[T], error) {
+	endpoint, err := a.resourceEndpoint(resource)
+	if err != nil {
+		return nil, err
 	}
 
 	query := endpoint.Query()
@@ -194,27 +457,163 @@ func (a *AviationStackProvider) fetchFlights(ctx context.Context, params url.Val
 	query.Set("access_key", a.APIKey)
 	endpoint.RawQuery = query.Encode()
 
+	client, err := a.client()
+	if err != nil {
+		return nil, err
+	}
+
+	return withRetries(ctx, a.retryPolicy(), func() (*aviationStackResponse[T], error) {
+		return fetchOnce[T](ctx, a, client, endpoint)
+	})
+}
+
+// waitForRateLimit blocks until RateLimiter allows another request. Only
+// the caller giving up stops the lookup; a broken limiter is logged and
+// otherwise ignored.
+func (a *AviationStackProvider) waitForRateLimit(ctx context.Context) error {
+	if a.RateLimiter == nil {
+		return nil
+	}
+	start := time.Now()
+	err := a.RateLimiter.Wait(ctx)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	if a.Logger != nil {
+		if err != nil {
+			a.Logger.Printf("rate limiter unavailable: %v", err)
+		} else if waited := time.Since(start); waited >= 100*time.Millisecond {
+			a.Logger.Printf("AviationStack: waited %s for rate limit", waited.Round(time.Millisecond))
+		}
+	}
+	return nil
+}
+
+func fetchOnce[T any](ctx context.Context, a *AviationStackProvider, client *http.Client, endpoint *url.URL) (*aviationStackResponse[T], error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
 	if err != nil {
 		return nil, redactedErrorf(err, "building AviationStack request for %s", redactAccessKey(endpoint.String()))
 	}
-	resp, err := providerHTTPClient.Do(req)
+	if err := a.waitForRateLimit(ctx); err != nil {
+		return nil, err
+	}
+	if a.Quota != nil {
+		err := a.Quota.Reserve(endpoint.Path, endpoint.Query())
+		if errors.Is(err, quota.ErrBudgetExceeded) {
+			return nil, err
+		}
+		// An unwritable ledger should not stop the lookup itself.
+		if err != nil && a.Logger != nil {
+			a.Logger.Printf("recording API usage: %v", err)
+		}
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		return nil, redactedErrorf(err, "failed to reach AviationStack API: %s", sanitizedProviderErrorText(err.Error()))
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("AviationStack API returned status %d", resp.StatusCode)
+	var data aviationStackResponse[T]
+	body := io.LimitReader(resp.Body, 10<<20)
+	decodeErr := json.NewDecoder(body).Decode(&data)
+
+	// Error responses usually carry an {"error": {...}} envelope, and
+	// sometimes it comes with 200 OK.
+	if resp.StatusCode != http.StatusOK || data.Error != nil {
+		apiErr := &APIError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		}
+		if decodeErr == nil && data.Error != nil {
+			apiErr.Code = data.Error.Code
+			apiErr.Message = data.Error.Message
+		}
+		return nil, apiErr
+	}
+	if decodeErr != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", decodeErr)
 	}
 
-	var data aviationStackResponse
-	body := io.LimitReader(resp.Body, 10<<20)
-	if err := json.NewDecoder(body).Decode(&data); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	return &data, nil
+}
+
+func (a *AviationStackProvider) endpoint() (*url.URL, error) {
+	raw := strings.TrimSpace(a.Endpoint)
+	if raw == "" {
+		raw = DefaultAviationStackEndpoint
+	}
+	endpoint, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid AviationStack endpoint: %w", err)
+	}
+	if (endpoint.Scheme != "https" && endpoint.Scheme != "http") || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid AviationStack endpoint %q: use an http(s) URL", sanitize.TerminalString(raw))
+	}
+	return endpoint, nil
+}
+
+// resourceEndpoint returns the URL of another AviationStack endpoint, such
+// as flightsFuture, next to the configured flights endpoint.
+func (a *AviationStackProvider) resourceEndpoint(resource string) (*url.URL, error) {
+	endpoint, err := a.endpoint()
+	if err != nil || resource == flightsResource {
+		return endpoint, err
+	}
+	endpoint.Path = path.Join(path.Dir(endpoint.Path), resource)
+	endpoint.RawPath = ""
+	return endpoint, nil
+}
+
+// SendsKeyInClear reports whether the configured endpoint is plain http to
+// a host other than this machine, which exposes the access key to anyone on
+// the network path.
+func (a *AviationStackProvider) SendsKeyInClear() bool {
+	endpoint, err := a.endpoint()
+	return err == nil && endpoint.Scheme == "http" && !isLoopbackHost(endpoint.Hostname())
+}
+
+func (a *AviationStackProvider) retryPolicy() retryPolicy {
+	return retryPolicy{
+		name:       "AviationStack",
+		maxRetries: a.MaxRetries,
+		backoff:    a.RetryBackoff,
+		logger:     a.Logger,
+	}
+}
+
+func (a *AviationStackProvider) client() (*http.Client, error) {
+	if a.CAFile == "" && a.ProxyURL == "" {
+		client := httpClient(a.Transport)
+		if a.Timeout > 0 && a.Timeout != client.Timeout {
+			withTimeout := *client
+			withTimeout.Timeout = a.Timeout
+			return &withTimeout, nil
+		}
+		return client, nil
+	}
+	if a.Transport != nil {
+		return nil, fmt.Errorf("AviationStack CA file and proxy cannot be combined with a custom transport")
 	}
 
-	return data.Data, nil
+	a.transportOnce.Do(func() {
+		a.transport, a.transportErr = NewHTTPTransport(a.CAFile, a.ProxyURL)
+	})
+	if a.transportErr != nil {
+		return nil, a.transportErr
+	}
+	timeout := a.Timeout
+	if timeout <= 0 {
+		timeout = providerHTTPClient.Timeout
+	}
+	return &http.Client{Timeout: timeout, Transport: a.transport}, nil
+}
+
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
 }
 
 func airportFlightFromAviationStack(f aviationStackFlight, scheduled time.Time) models.AirportFlight {
@@ -234,6 +633,14 @@ func airportFlightFromAviationStack(f aviationStackFlight, scheduled time.Time)
 		DepartureTime: departureTime,
 		ArrivalTime:   arrivalTime,
 		ScheduledTime: scheduled,
+
+		DepartureDetails: legDetailsFromAviationStack(f.Departure),
+		ArrivalDetails:   legDetailsFromAviationStack(f.Arrival),
+		Aircraft:         aircraftFromAviationStack(f.Aircraft),
+	}
+	if number, operator, ok := f.operatingFlight(); ok {
+		flight.OperatingFlightNumber = number
+		flight.OperatingAirline = operator
 	}
 
 	if f.Live != nil {
@@ -246,6 +653,36 @@ func airportFlightFromAviationStack(f aviationStackFlight, scheduled time.Time)
 	return flight
 }
 
+// legDetailsFromAviationStack keeps the gate, delay and separate times
+// that the summary DepartureTime and ArrivalTime collapse together.
+func legDetailsFromAviationStack(a aviationStackAirport) models.LegDetails {
+	details := models.LegDetails{
+		Airport:   strings.TrimSpace(a.Airport),
+		Terminal:  strings.TrimSpace(a.Terminal),
+		Gate:      strings.TrimSpace(a.Gate),
+		Baggage:   strings.TrimSpace(a.Baggage),
+		Scheduled: parseLocalTime(a.Timezone, a.Scheduled),
+		Estimated: parseLocalTime(a.Timezone, a.Estimated),
+		Actual:    parseLocalTime(a.Timezone, a.Actual),
+	}
+	if a.Delay != nil {
+		details.Delay = *a.Delay
+	}
+	return details
+}
+
+func aircraftFromAviationStack(a *aviationStackPlane) models.Aircraft {
+	if a == nil {
+		return models.Aircraft{}
+	}
+	return models.Aircraft{
+		Registration: strings.ToUpper(strings.TrimSpace(a.Registration)),
+		ICAOType:     strings.ToUpper(strings.TrimSpace(a.ICAO)),
+		IATAType:     strings.ToUpper(strings.TrimSpace(a.IATA)),
+		ICAO24:       strings.ToUpper(strings.TrimSpace(a.ICAO24)),
+	}
+}
+
 // bestFlight picks the most relevant flight from multiple results.
 // It prefers status priority (active > landed > scheduled), but also
 // considers recency: a stale completed flight from over a day before
@@ -351,6 +788,9 @@ func flightPriority(status string) int {
 }
 
 func normalizeFlightNumber(input string) string {
+	if flight, ok := flightFromTelephony(input); ok {
+		input = flight
+	}
 	if len(input) >= 3 && input[0] >= '0' && input[0] <= '9' && input[1] >= 'A' && input[1] <= 'Z' {
 		allDigits := true
 		for i := 2; i < len(input); i++ {
@@ -499,6 +939,22 @@ func redactedErrorf(err error, format string, args ...interface{}) error {
 	}
 }
 
+type notFoundError struct {
+	message string
+}
+
+func (e notFoundError) Error() string {
+	return e.message
+}
+
+func (e notFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+func notFoundf(format string, args ...interface{}) error {
+	return notFoundError{message: fmt.Sprintf(format, args...)}
+}
+
 func redactAccessKey(rawURL string) string {
 	return redactAccessKeyInText(rawURL)
 }
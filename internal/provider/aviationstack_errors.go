@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/sanitize"
+)
+
+// Errors matched (via errors.Is) by an *APIError, by what the caller can do
+// about them.
+var (
+	// ErrInvalidAPIKey means the access key is missing, wrong or disabled.
+	ErrInvalidAPIKey = errors.New("invalid API key")
+	// ErrQuotaExceeded means the plan's monthly request allowance is used up.
+	ErrQuotaExceeded = errors.New("API usage limit reached")
+	// ErrFunctionRestricted means the plan does not include the request,
+	// such as HTTPS on the free tier.
+	ErrFunctionRestricted = errors.New("not available on this API plan")
+	// ErrRateLimited means too many requests were sent too quickly.
+	ErrRateLimited = errors.New("API rate limit reached")
+)
+
+// AviationStack error codes, from the "error.code" field of its responses.
+const (
+	codeInvalidAccessKey         = "invalid_access_key"
+	codeMissingAccessKey         = "missing_access_key"
+	codeInactiveUser             = "inactive_user"
+	codeUsageLimitReached        = "usage_limit_reached"
+	codeRateLimitReached         = "rate_limit_reached"
+	codeFunctionAccessRestricted = "function_access_restricted"
+	codeHTTPSAccessRestricted    = "https_access_restricted"
+)
+
+// APIError is an error response from AviationStack: a non-200 status, an
+// {"error": {...}} envelope, or both. The envelope sometimes arrives with
+// 200 OK.
+type APIError struct {
+	StatusCode int
+	// Code and Message come from the error envelope and are empty when the
+	// response had none.
+	Code    string
+	Message string
+	// RetryAfter is the server's requested wait, or zero if it sent none.
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("AviationStack API returned status %d", e.StatusCode)
+	}
+	message := sanitizedProviderErrorText(e.Message)
+	if message == "" {
+		return fmt.Sprintf("AviationStack API error: %s", sanitize.TerminalString(e.Code))
+	}
+	return fmt.Sprintf("AviationStack API error: %s (%s)", message, sanitize.TerminalString(e.Code))
+}
+
+// Is matches the error against ErrInvalidAPIKey, ErrQuotaExceeded,
+// ErrFunctionRestricted and ErrRateLimited. When the envelope is missing,
+// the status code decides.
+func (e *APIError) Is(target error) bool {
+	return e.kind() == target
+}
+
+// HTTPSRestricted reports whether the plan only allows plain http requests.
+func (e *APIError) HTTPSRestricted() bool {
+	return e.Code == codeHTTPSAccessRestricted
+}
+
+func (e *APIError) kind() error {
+	switch e.Code {
+	case codeInvalidAccessKey, codeMissingAccessKey, codeInactiveUser:
+		return ErrInvalidAPIKey
+	case codeUsageLimitReached:
+		return ErrQuotaExceeded
+	case codeRateLimitReached:
+		return ErrRateLimited
+	case codeFunctionAccessRestricted, codeHTTPSAccessRestricted:
+		return ErrFunctionRestricted
+	case "":
+		switch e.StatusCode {
+		case http.StatusUnauthorized:
+			return ErrInvalidAPIKey
+		case http.StatusForbidden:
+			return ErrFunctionRestricted
+		case http.StatusTooManyRequests:
+			return ErrRateLimited
+		}
+	}
+	return nil
+}
+
+type aviationStackError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/airlines"
+	"github.com/joshuachuah/flightcli/internal/models"
+)
+
+// aviationStackScheduleRow is one entry from the flightsFuture endpoint.
+// Codes come back in lower case. When Codeshared is set, the row is a
+// codeshare sold by Airline and Codeshared names the operating flight.
+type aviationStackScheduleRow struct {
+	Departure  aviationStackScheduleStop    `json:"departure"`
+	Arrival    aviationStackScheduleStop    `json:"arrival"`
+	Airline    aviationStackScheduleCarrier `json:"airline"`
+	Flight     aviationStackScheduleNumber  `json:"flight"`
+	Codeshared *struct {
+		Airline aviationStackScheduleCarrier `json:"airline"`
+		Flight  aviationStackScheduleNumber  `json:"flight"`
+	} `json:"codeshared"`
+}
+
+type aviationStackScheduleStop struct {
+	IATACode      string `json:"iataCode"`
+	ScheduledTime string `json:"scheduledTime"`
+}
+
+type aviationStackScheduleCarrier struct {
+	Name     string `json:"name"`
+	IATACode string `json:"iataCode"`
+	ICAOCode string `json:"icaoCode"`
+}
+
+type aviationStackScheduleNumber struct {
+	Number     string `json:"number"`
+	IATANumber string `json:"iataNumber"`
+}
+
+// GetSchedule returns the timetable from one airport to another on a
+// future date. AviationStack only lists departures by airport, so the pages
+// of departures page selects are fetched and filtered to the destination;
+// the zero Page is one request. page.Window is not supported.
+func (a *AviationStackProvider) GetSchedule(ctx context.Context, from, to string, date time.Time, page Page) ([]models.ScheduledFlight, error) {
+	from = strings.ToUpper(strings.TrimSpace(from))
+	to = strings.ToUpper(strings.TrimSpace(to))
+
+	params := url.Values{
+		"iataCode": []string{from},
+		"type":     []string{"departure"},
+		"date":     []string{date.Format(DateLayout)},
+	}
+	if page.Window > 0 {
+		return nil, fmt.Errorf("a time window is not supported for timetables")
+	}
+	rows, err := fetchPages[aviationStackScheduleRow](ctx, a, scheduleResource, params, page, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var flights []models.ScheduledFlight
+	for _, row := range rows {
+		if strings.EqualFold(row.Arrival.IATACode, to) {
+			flights = append(flights, scheduledFlightFromAviationStack(row, from, to, date))
+		}
+	}
+	if len(flights) == 0 {
+		return nil, notFoundf("no scheduled flights found for route %s -> %s%s", from, to, onDate(date))
+	}
+	return flights, nil
+}
+
+func scheduledFlightFromAviationStack(row aviationStackScheduleRow, from, to string, date time.Time) models.ScheduledFlight {
+	flight := models.ScheduledFlight{
+		FlightNumber:  strings.ToUpper(row.Flight.IATANumber),
+		Airline:       scheduleAirlineName(row.Airline),
+		Origin:        from,
+		Destination:   to,
+		Date:          date.Format(DateLayout),
+		DepartureTime: row.Departure.ScheduledTime,
+		ArrivalTime:   row.Arrival.ScheduledTime,
+	}
+	if flight.FlightNumber == "" {
+		flight.FlightNumber = strings.ToUpper(row.Airline.IATACode + row.Flight.Number)
+	}
+	if row.Codeshared != nil {
+		flight.OperatingFlightNumber = strings.ToUpper(row.Codeshared.Flight.IATANumber)
+		if flight.OperatingFlightNumber == "" {
+			flight.OperatingFlightNumber = strings.ToUpper(row.Codeshared.Airline.IATACode + row.Codeshared.Flight.Number)
+		}
+		flight.OperatingAirline = scheduleAirlineName(row.Codeshared.Airline)
+	}
+
+	// Overnight flights land on a later day than they leave.
+	departure, depErr := time.Parse("15:04", row.Departure.ScheduledTime)
+	arrival, arrErr := time.Parse("15:04", row.Arrival.ScheduledTime)
+	if depErr == nil && arrErr == nil && arrival.Before(departure) {
+		flight.ArrivalDayOffset = 1
+	}
+	return flight
+}
+
+func scheduleAirlineName(carrier aviationStackScheduleCarrier) string {
+	return airlineName(carrier.ICAOCode, carrier.Name)
+}
+
+// airlineName prefers the embedded dataset's name for an ICAO code, since
+// AviationStack often returns codeshare and schedule names in lower case.
+func airlineName(icao, name string) string {
+	if airline := airlines.ByICAO(icao); airline != nil {
+		return airline.Name
+	}
+	return name
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+const scheduleFixture = `{"pagination":{"limit":100,"offset":0,"count":3,"total":3},"data":[
+{"departure":{"iataCode":"jfk","scheduledTime":"08:00"},"arrival":{"iataCode":"lax","scheduledTime":"11:25"},
+ "airline":{"name":"american airlines","iataCode":"aa","icaoCode":"aal"},"flight":{"number":"1","iataNumber":"aa1"}},
+{"departure":{"iataCode":"jfk","scheduledTime":"22:15"},"arrival":{"iataCode":"lax","scheduledTime":"01:40"},
+ "airline":{"name":"virgin atlantic","iataCode":"vs","icaoCode":"vir"},"flight":{"number":"3412","iataNumber":"vs3412"},
+ "codeshared":{"airline":{"name":"delta air lines","iataCode":"dl","icaoCode":"dal"},"flight":{"number":"915","iataNumber":"dl915"}}},
+{"departure":{"iataCode":"jfk","scheduledTime":"09:30"},"arrival":{"iataCode":"sfo","scheduledTime":"12:55"},
+ "airline":{"name":"united airlines","iataCode":"ua","icaoCode":"ual"},"flight":{"number":"15","iataNumber":"ua15"}}
+]}`
+
+func TestGetScheduleQueriesFutureDeparturesAndFiltersRoute(t *testing.T) {
+	provider := &AviationStackProvider{APIKey: "secret-key"}
+
+	withTestHTTPClient(t, func(req *http.Request) {
+		if req.URL.Path != "/v1/flightsFuture" {
+			t.Fatalf("expected /v1/flightsFuture, got %q", req.URL.Path)
+		}
+		query := req.URL.Query()
+		if query.Get("iataCode") != "JFK" || query.Get("type") != "departure" || query.Get("date") != "2026-12-20" {
+			t.Fatalf("unexpected schedule query: %s", req.URL.RawQuery)
+		}
+	}, func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, scheduleFixture)
+	})
+
+	date := time.Date(2026, 12, 20, 0, 0, 0, 0, time.Local)
+	flights, err := provider.GetSchedule(context.Background(), "jfk", "lax", date, Page{})
+	if err != nil {
+		t.Fatalf("GetSchedule returned error: %v", err)
+	}
+	if len(flights) != 2 {
+		t.Fatalf("expected the SFO flight to be filtered out, got %d flights: %#v", len(flights), flights)
+	}
+
+	first := flights[0]
+	if first.FlightNumber != "AA1" || first.Airline != "American Airlines" || first.Codeshare() {
+		t.Fatalf("unexpected first flight: %#v", first)
+	}
+	if first.Origin != "JFK" || first.Destination != "LAX" || first.Date != "2026-12-20" || first.ArrivalDayOffset != 0 {
+		t.Fatalf("unexpected first flight route: %#v", first)
+	}
+
+	overnight := flights[1]
+	if !overnight.Codeshare() || overnight.OperatingFlightNumber != "DL915" || overnight.OperatingAirline != "Delta Air Lines" {
+		t.Fatalf("expected codeshare operated by Delta DL915, got %#v", overnight)
+	}
+	if overnight.DepartureTime != "22:15" || overnight.ArrivalTime != "01:40" || overnight.ArrivalDayOffset != 1 {
+		t.Fatalf("expected overnight arrival on the next day, got %#v", overnight)
+	}
+}
+
+func TestGetScheduleFetchesOnePageUnlessAsked(t *testing.T) {
+	provider := &AviationStackProvider{APIKey: "secret-key"}
+
+	requests := 0
+	withTestHTTPClient(t, func(*http.Request) { requests++ }, func(w http.ResponseWriter, req *http.Request) {
+		// Claim more departures remain than were returned.
+		fmt.Fprint(w, strings.Replace(scheduleFixture, `"total":3`, `"total":300`, 1))
+	})
+
+	date := time.Date(2026, 12, 20, 0, 0, 0, 0, time.Local)
+	if _, err := provider.GetSchedule(context.Background(), "JFK", "LAX", date, Page{}); err != nil {
+		t.Fatalf("GetSchedule returned error: %v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("expected a single request by default, got %d", requests)
+	}
+
+	requests = 0
+	if _, err := provider.GetSchedule(context.Background(), "JFK", "LAX", date, Page{Max: 9}); err != nil {
+		t.Fatalf("GetSchedule returned error: %v", err)
+	}
+	if requests != 3 {
+		t.Fatalf("expected --max 9 to fetch three pages of three, got %d requests", requests)
+	}
+
+	if _, err := provider.GetSchedule(context.Background(), "JFK", "LAX", date, Page{Window: time.Hour}); err == nil {
+		t.Fatal("expected a time window to be rejected")
+	}
+}
+
+func TestGetScheduleNotFoundNamesRouteAndDate(t *testing.T) {
+	provider := &AviationStackProvider{APIKey: "secret-key"}
+
+	withTestHTTPClient(t, func(*http.Request) {}, func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, scheduleFixture)
+	})
+
+	date := time.Date(2026, 12, 20, 0, 0, 0, 0, time.Local)
+	_, err := provider.GetSchedule(context.Background(), "JFK", "BOS", date, Page{})
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if want := "no scheduled flights found for route JFK -> BOS on 2026-12-20"; err.Error() != want {
+		t.Fatalf("expected %q, got %q", want, err.Error())
+	}
+}
+
+func TestScheduleRequiresScheduleProvider(t *testing.T) {
+	_, err := Schedule(context.Background(), &MockProvider{}, "JFK", "LAX", time.Now(), Page{})
+	if !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("expected ErrNotSupported, got %v", err)
+	}
+}
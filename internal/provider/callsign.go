@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/joshuachuah/flightcli/internal/airlines"
+)
+
+// callsignCandidates returns the ATC callsigns a flight number may be
+// broadcast under. Transponders use the ICAO designator ("UAL2189"), so an
+// IATA flight number is expanded through the airlines table first. When
+// the IATA code is shared by several airlines and none stands out, each of
+// them is tried rather than guessing one.
+func callsignCandidates(flightNumber string) []string {
+	input := normalizeFlightNumber(strings.ToUpper(strings.TrimSpace(flightNumber)))
+	if prefix, _ := splitFlightNumber(input); len(prefix) == 3 || len(input) < 3 {
+		return []string{input}
+	}
+
+	iata := input[:2]
+	candidates := make([]string, 0, 2)
+	for _, a := range airlines.AllByIATA(iata) {
+		candidates = append(candidates, a.ICAO+input[2:])
+		if !airlines.Ambiguous(iata) {
+			break
+		}
+	}
+	return append(candidates, input)
+}
+
+// flightFromCallsign maps an ATC callsign such as "UAL2189 " to the IATA
+// flight number and airline name used elsewhere in the CLI. Callsigns whose
+// prefix is not in the airlines table are returned unchanged.
+func flightFromCallsign(callsign string) (flightNumber, airline string) {
+	callsign = strings.ToUpper(strings.TrimSpace(callsign))
+	prefix, num := splitFlightNumber(callsign)
+	if len(prefix) != 3 || num == "" {
+		return callsign, ""
+	}
+
+	a := airlines.ByICAO(prefix)
+	if a == nil {
+		return callsign, ""
+	}
+	if a.IATA == "" {
+		return normalizeFlightNumber(callsign), a.Name
+	}
+	return normalizeFlightNumber(a.IATA + num), a.Name
+}
+
+// splitFlightNumber splits "UAL2189" into its letter prefix and the rest.
+func splitFlightNumber(input string) (string, string) {
+	i := 0
+	for i < len(input) && (input[i] >= 'A' && input[i] <= 'Z') {
+		i++
+	}
+	return input[:i], input[i:]
+}
+
+// flightFromTelephony resolves a radio callsign as spoken on air, such as
+// "SPEEDBIRD 12" or "AMERICAN100", to the ICAO flight number "BAW12". ok is
+// false when input is not a telephony callsign or the airlines table does
+// not know it. A name of three letters or fewer only counts when it is
+// followed by a space or hyphen ("REX 123"), so flight numbers such as
+// "ABG123" and "LAT45" are left alone even though ABG and LAT are callsigns.
+func flightFromTelephony(input string) (flightNumber string, ok bool) {
+	name, num, isTelephony := splitTelephony(input)
+	if !isTelephony {
+		return "", false
+	}
+	a := airlines.ByCallsign(name)
+	if a == nil {
+		return "", false
+	}
+	// A short callsign can be another airline's code: "CAL 5" is China
+	// Airlines (ICAO CAL), not CAL Cargo, whose callsign is "CAL".
+	if code := airlineByCode(name); code != nil && code.ICAO != a.ICAO {
+		return "", false
+	}
+	return a.ICAO + num, true
+}
+
+// airlineByCode returns the airline whose ICAO or IATA code is name, or nil.
+func airlineByCode(name string) *airlines.Airline {
+	switch len(name) {
+	case 3:
+		return airlines.ByICAO(name)
+	case 2:
+		return airlines.ByIATA(name)
+	}
+	return nil
+}
+
+// splitTelephony splits "SPEEDBIRD 12" into the spoken airline name and the
+// flight number. ok is false unless the name has only letters, spaces and
+// hyphens, and the number only letters and digits. A name of three letters
+// or fewer must also be set apart by a space or hyphen; otherwise it is the
+// airline code of a flight number such as "BA12" or "BAW12".
+func splitTelephony(input string) (name, num string, ok bool) {
+	input = strings.ToUpper(strings.TrimSpace(input))
+	i := strings.IndexAny(input, "0123456789")
+	if i <= 0 {
+		return "", "", false
+	}
+	name, num = strings.TrimSpace(input[:i]), input[i:]
+	for _, r := range name {
+		if (r < 'A' || r > 'Z') && r != ' ' && r != '-' {
+			return "", "", false
+		}
+	}
+	for _, r := range num {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return "", "", false
+		}
+	}
+	if name == "" || (len(name) <= 3 && !strings.ContainsAny(input[:i], " -")) {
+		return "", "", false
+	}
+	return name, num, true
+}
+
+// CheckFlightNumber rejects a radio callsign such as "SPEEDBRID 12" whose
+// airline is not in the airlines table, suggesting a close match when there
+// is one. Flight numbers are left for the provider to look up, as are names
+// of three letters or fewer, which may be airline codes missing from the
+// table.
+func CheckFlightNumber(input string) error {
+	name, _, isTelephony := splitTelephony(input)
+	if !isTelephony || airlines.ByCallsign(name) != nil {
+		return nil
+	}
+	if letters := strings.NewReplacer(" ", "", "-", "").Replace(name); len(letters) <= 3 {
+		return nil
+	}
+	for _, a := range airlines.Search(name) {
+		if a.Callsign != "" {
+			return fmt.Errorf("unknown airline callsign %q: did you mean %s (%s)?", name, a.Callsign, a.Name)
+		}
+	}
+	return fmt.Errorf("unknown airline callsign %q: use a flight number such as BA12, or try 'flightcli airline search'", name)
+}
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/models"
+)
+
+const defaultChainCooldown = 5 * time.Minute
+
+// NamedProvider labels a provider within a ChainProvider. The name is
+// reported as the Source of every result it answers.
+type NamedProvider struct {
+	Name     string
+	Provider FlightProvider
+}
+
+// ProviderHealth is a snapshot of one chain member's recent failures.
+type ProviderHealth struct {
+	Name           string
+	Failures       int
+	LastError      string
+	UnhealthyUntil time.Time
+}
+
+// ChainProvider tries an ordered list of providers until one answers.
+// It keeps the optional abilities of its members: options and timetables
+// are passed to each member that supports them, and live members are
+// started together with their updates merged.
+//
+// A provider that fails (anything other than "not found", "not supported"
+// or a canceled request) is marked unhealthy and skipped for Cooldown.
+// If every provider is cooling down, they are tried anyway rather than
+// failing without asking anyone.
+type ChainProvider struct {
+	Providers []NamedProvider
+	// Cooldown is how long a failed provider is skipped. Defaults to 5 minutes.
+	Cooldown time.Duration
+
+	mu     sync.Mutex
+	health map[string]*ProviderHealth
+	now    func() time.Time
+
+	// feeds and merged cache the last channel Updates returned, so asking
+	// again before any member hears anything does not start another wait.
+	feeds  []<-chan struct{}
+	merged chan struct{}
+}
+
+func (c *ChainProvider) GetFlightStatus(ctx context.Context, flightNumber string) (*models.Flight, error) {
+	return c.GetFlightStatusWithOptions(ctx, flightNumber, Options{})
+}
+
+// GetFlightStatusWithOptions passes opts on to each member that honors them.
+func (c *ChainProvider) GetFlightStatusWithOptions(ctx context.Context, flightNumber string, opts Options) (*models.Flight, error) {
+	flight, source, err := chainCall(ctx, c, func(p FlightProvider) (*models.Flight, error) {
+		return StatusWithOptions(ctx, p, flightNumber, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if flight.Source == "" {
+		flight.Source = source
+	}
+	return flight, nil
+}
+
+func (c *ChainProvider) GetAirportFlights(ctx context.Context, airportCode string, flightType string) ([]models.AirportFlight, error) {
+	return c.GetAirportFlightsWithOptions(ctx, airportCode, flightType, Options{})
+}
+
+// GetAirportFlightsWithOptions passes opts on to each member that honors them.
+func (c *ChainProvider) GetAirportFlightsWithOptions(ctx context.Context, airportCode string, flightType string, opts Options) ([]models.AirportFlight, error) {
+	flights, source, err := chainCall(ctx, c, func(p FlightProvider) ([]models.AirportFlight, error) {
+		return AirportFlightsWithOptions(ctx, p, airportCode, flightType, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	setBoardSource(flights, source)
+	return flights, nil
+}
+
+func (c *ChainProvider) SearchFlights(ctx context.Context, from, to string) ([]models.AirportFlight, error) {
+	return c.SearchFlightsWithOptions(ctx, from, to, Options{})
+}
+
+// SearchFlightsWithOptions passes opts on to each member that honors them.
+func (c *ChainProvider) SearchFlightsWithOptions(ctx context.Context, from, to string, opts Options) ([]models.AirportFlight, error) {
+	flights, source, err := chainCall(ctx, c, func(p FlightProvider) ([]models.AirportFlight, error) {
+		return SearchWithOptions(ctx, p, from, to, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	setBoardSource(flights, source)
+	return flights, nil
+}
+
+// GetSchedule asks each member that knows timetables in turn.
+func (c *ChainProvider) GetSchedule(ctx context.Context, from, to string, date time.Time, page Page) ([]models.ScheduledFlight, error) {
+	flights, source, err := chainCall(ctx, c, func(p FlightProvider) ([]models.ScheduledFlight, error) {
+		return Schedule(ctx, p, from, to, date, page)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range flights {
+		if flights[i].Source == "" {
+			flights[i].Source = source
+		}
+	}
+	return flights, nil
+}
+
+// Start starts every member fed by a continuous stream, such as an SBS
+// feed, so a chain with one keeps its live updates.
+func (c *ChainProvider) Start(ctx context.Context) {
+	for _, p := range c.Providers {
+		if live, ok := p.Provider.(LiveProvider); ok {
+			live.Start(ctx)
+		}
+	}
+}
+
+// Updates returns a channel that is closed when any live member receives
+// new data, or nil when no member is live.
+func (c *ChainProvider) Updates() <-chan struct{} {
+	var feeds []<-chan struct{}
+	for _, p := range c.Providers {
+		if live, ok := p.Provider.(LiveProvider); ok {
+			feeds = append(feeds, live.Updates())
+		}
+	}
+	switch len(feeds) {
+	case 0:
+		return nil
+	case 1:
+		return feeds[0]
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if slices.Equal(feeds, c.feeds) {
+		return c.merged
+	}
+	merged := make(chan struct{})
+	c.feeds, c.merged = feeds, merged
+	go func() {
+		cases := make([]reflect.SelectCase, len(feeds))
+		for i, feed := range feeds {
+			cases[i] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(feed)}
+		}
+		reflect.Select(cases)
+		close(merged)
+	}()
+	return merged
+}
+
+// Health returns the failure record of every chain member, in chain order.
+func (c *ChainProvider) Health() []ProviderHealth {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	out := make([]ProviderHealth, 0, len(c.Providers))
+	for _, p := range c.Providers {
+		if h, ok := c.health[p.Name]; ok {
+			out = append(out, *h)
+		} else {
+			out = append(out, ProviderHealth{Name: p.Name})
+		}
+	}
+	return out
+}
+
+func chainCall[T any](ctx context.Context, c *ChainProvider, call func(FlightProvider) (T, error)) (T, string, error) {
+	var (
+		zero    T
+		errs    []error
+		skipped []NamedProvider
+	)
+
+	try := func(p NamedProvider) (T, bool, error) {
+		value, err := call(p.Provider)
+		if err == nil {
+			c.recordSuccess(p.Name)
+			return value, true, nil
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return zero, false, ctxErr
+		}
+		if !errors.Is(err, ErrNotFound) && !errors.Is(err, ErrNotSupported) {
+			c.recordFailure(p.Name, err)
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", p.Name, err))
+		return zero, false, nil
+	}
+
+	for _, p := range c.Providers {
+		if !c.healthy(p.Name) {
+			skipped = append(skipped, p)
+			continue
+		}
+		value, ok, err := try(p)
+		if err != nil {
+			return zero, "", err
+		}
+		if ok {
+			return value, p.Name, nil
+		}
+	}
+
+	if len(errs) == 0 {
+		for _, p := range skipped {
+			value, ok, err := try(p)
+			if err != nil {
+				return zero, "", err
+			}
+			if ok {
+				return value, p.Name, nil
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return zero, "", fmt.Errorf("no providers configured")
+	}
+	if len(errs) == 1 {
+		return zero, "", errs[0]
+	}
+	return zero, "", errors.Join(errs...)
+}
+
+func (c *ChainProvider) healthy(name string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	h, ok := c.health[name]
+	return !ok || !c.clock().Before(h.UnhealthyUntil)
+}
+
+func (c *ChainProvider) recordSuccess(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.health, name)
+}
+
+func (c *ChainProvider) recordFailure(name string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.health == nil {
+		c.health = make(map[string]*ProviderHealth)
+	}
+	h, ok := c.health[name]
+	if !ok {
+		h = &ProviderHealth{Name: name}
+		c.health[name] = h
+	}
+
+	cooldown := c.Cooldown
+	if cooldown <= 0 {
+		cooldown = defaultChainCooldown
+	}
+	h.Failures++
+	h.LastError = err.Error()
+	h.UnhealthyUntil = c.clock().Add(cooldown)
+}
+
+func (c *ChainProvider) clock() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+	return time.Now()
+}
+
+func setBoardSource(flights []models.AirportFlight, source string) {
+	for i := range flights {
+		if flights[i].Source == "" {
+			flights[i].Source = source
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/models"
+)
+
+type stubProvider struct {
+	err   error
+	calls int
+}
+
+func (s *stubProvider) GetFlightStatus(ctx context.Context, flightNumber string) (*models.Flight, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &models.Flight{FlightNumber: flightNumber}, nil
+}
+
+func (s *stubProvider) GetAirportFlights(ctx context.Context, airportCode string, flightType string) ([]models.AirportFlight, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return []models.AirportFlight{{FlightNumber: "DL123"}, {FlightNumber: "UA456"}}, nil
+}
+
+func (s *stubProvider) SearchFlights(ctx context.Context, from, to string) ([]models.AirportFlight, error) {
+	return s.GetAirportFlights(ctx, from, "departure")
+}
+
+func TestChainFallsBackAndLabelsSource(t *testing.T) {
+	primary := &stubProvider{err: errors.New("status 500")}
+	secondary := &stubProvider{}
+	chain := &ChainProvider{Providers: []NamedProvider{
+		{Name: "aviationstack", Provider: primary},
+		{Name: "opensky", Provider: secondary},
+	}}
+
+	flight, err := chain.GetFlightStatus(context.Background(), "UA2189")
+	if err != nil {
+		t.Fatalf("GetFlightStatus returned error: %v", err)
+	}
+	if flight.Source != "opensky" {
+		t.Fatalf("expected source opensky, got %q", flight.Source)
+	}
+
+	flights, err := chain.GetAirportFlights(context.Background(), "JFK", "departure")
+	if err != nil {
+		t.Fatalf("GetAirportFlights returned error: %v", err)
+	}
+	for _, f := range flights {
+		if f.Source != "opensky" {
+			t.Fatalf("expected every row labelled opensky, got %#v", flights)
+		}
+	}
+
+	health := chain.Health()
+	if len(health) != 2 || health[0].Failures != 1 || health[1].Failures != 0 {
+		t.Fatalf("unexpected health: %#v", health)
+	}
+	if !strings.Contains(health[0].LastError, "status 500") {
+		t.Fatalf("expected last error recorded, got %q", health[0].LastError)
+	}
+}
+
+func TestChainSkipsUnhealthyProviderUntilCooldownExpires(t *testing.T) {
+	now := time.Date(2026, 3, 13, 12, 0, 0, 0, time.UTC)
+	primary := &stubProvider{err: errors.New("timeout")}
+	secondary := &stubProvider{}
+	chain := &ChainProvider{
+		Providers: []NamedProvider{
+			{Name: "aviationstack", Provider: primary},
+			{Name: "opensky", Provider: secondary},
+		},
+		Cooldown: time.Minute,
+		now:      func() time.Time { return now },
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := chain.GetFlightStatus(context.Background(), "UA2189"); err != nil {
+			t.Fatalf("GetFlightStatus returned error: %v", err)
+		}
+	}
+	if primary.calls != 1 {
+		t.Fatalf("expected failing provider to be skipped during cooldown, got %d calls", primary.calls)
+	}
+
+	now = now.Add(2 * time.Minute)
+	primary.err = nil
+	flight, err := chain.GetFlightStatus(context.Background(), "UA2189")
+	if err != nil {
+		t.Fatalf("GetFlightStatus returned error: %v", err)
+	}
+	if flight.Source != "aviationstack" || primary.calls != 2 {
+		t.Fatalf("expected primary retried after cooldown, got source %q after %d calls", flight.Source, primary.calls)
+	}
+	if chain.Health()[0].Failures != 0 {
+		t.Fatalf("expected success to clear health record, got %#v", chain.Health()[0])
+	}
+}
+
+func TestChainNotFoundDoesNotMarkProviderUnhealthy(t *testing.T) {
+	primary := &stubProvider{err: notFoundf("no flight found for UA2189")}
+	secondary := &stubProvider{err: ErrNotSupported}
+	chain := &ChainProvider{Providers: []NamedProvider{
+		{Name: "opensky", Provider: primary},
+		{Name: "adsb", Provider: secondary},
+	}}
+
+	_, err := chain.GetFlightStatus(context.Background(), "UA2189")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected joined error to match ErrNotFound, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "opensky: no flight found") || !strings.Contains(err.Error(), "adsb: ") {
+		t.Fatalf("expected every provider named in the error, got %q", err)
+	}
+	for _, h := range chain.Health() {
+		if h.Failures != 0 {
+			t.Fatalf("expected no failures recorded, got %#v", h)
+		}
+	}
+}
+
+func TestChainTriesUnhealthyProvidersWhenAllAreCoolingDown(t *testing.T) {
+	primary := &stubProvider{err: errors.New("status 503")}
+	chain := &ChainProvider{Providers: []NamedProvider{{Name: "aviationstack", Provider: primary}}}
+
+	if _, err := chain.GetFlightStatus(context.Background(), "UA2189"); err == nil {
+		t.Fatal("expected error from failing provider")
+	}
+	primary.err = nil
+	flight, err := chain.GetFlightStatus(context.Background(), "UA2189")
+	if err != nil {
+		t.Fatalf("expected cooling-down provider to be tried anyway, got %v", err)
+	}
+	if flight.Source != "aviationstack" || primary.calls != 2 {
+		t.Fatalf("unexpected result %#v after %d calls", flight, primary.calls)
+	}
+}
+
+func TestChainStopsOnCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	primary := &stubProvider{err: context.Canceled}
+	secondary := &stubProvider{}
+	chain := &ChainProvider{Providers: []NamedProvider{
+		{Name: "aviationstack", Provider: primary},
+		{Name: "opensky", Provider: secondary},
+	}}
+
+	if _, err := chain.GetFlightStatus(ctx, "UA2189"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if secondary.calls != 0 || chain.Health()[0].Failures != 0 {
+		t.Fatal("expected canceled request to neither fall back nor mark the provider unhealthy")
+	}
+}
+
+type datedStubProvider struct {
+	stubProvider
+	opts Options
+}
+
+func (s *datedStubProvider) GetFlightStatusWithOptions(ctx context.Context, flightNumber string, opts Options) (*models.Flight, error) {
+	s.opts = opts
+	return s.GetFlightStatus(ctx, flightNumber)
+}
+
+func (s *datedStubProvider) GetAirportFlightsWithOptions(ctx context.Context, airportCode string, flightType string, opts Options) ([]models.AirportFlight, error) {
+	s.opts = opts
+	return s.GetAirportFlights(ctx, airportCode, flightType)
+}
+
+func (s *datedStubProvider) SearchFlightsWithOptions(ctx context.Context, from, to string, opts Options) ([]models.AirportFlight, error) {
+	s.opts = opts
+	return s.SearchFlights(ctx, from, to)
+}
+
+func TestChainPassesOptionsToMembersThatHonorThem(t *testing.T) {
+	plain := &stubProvider{}
+	dated := &datedStubProvider{}
+	chain := &ChainProvider{Providers: []NamedProvider{
+		{Name: "opensky", Provider: plain},
+		{Name: "aviationstack", Provider: dated},
+	}}
+
+	date := time.Date(2026, 3, 10, 0, 0, 0, 0, time.Local)
+	flight, err := chain.GetFlightStatusWithOptions(context.Background(), "UA2189", Options{Date: date})
+	if err != nil {
+		t.Fatalf("GetFlightStatusWithOptions returned error: %v", err)
+	}
+	if flight.Source != "aviationstack" || !dated.opts.Date.Equal(date) || plain.calls != 0 {
+		t.Fatalf("expected the dated lookup to reach aviationstack only, got %#v with %#v", flight, dated.opts)
+	}
+	if chain.Health()[0].Failures != 0 {
+		t.Fatal("expected an unsupported date not to mark opensky unhealthy")
+	}
+}
+
+type scheduleStubProvider struct {
+	stubProvider
+}
+
+func (s *scheduleStubProvider) GetSchedule(ctx context.Context, from, to string, date time.Time, page Page) ([]models.ScheduledFlight, error) {
+	return []models.ScheduledFlight{{FlightNumber: "AA1", Origin: from, Destination: to}}, nil
+}
+
+func TestChainAsksMembersThatKnowTimetables(t *testing.T) {
+	chain := &ChainProvider{Providers: []NamedProvider{
+		{Name: "opensky", Provider: &stubProvider{}},
+		{Name: "aviationstack", Provider: &scheduleStubProvider{}},
+	}}
+
+	flights, err := Schedule(context.Background(), chain, "JFK", "LAX", time.Now(), Page{})
+	if err != nil {
+		t.Fatalf("Schedule returned error: %v", err)
+	}
+	if len(flights) != 1 || flights[0].Source != "aviationstack" {
+		t.Fatalf("expected the timetable from aviationstack, got %#v", flights)
+	}
+
+	plainOnly := &ChainProvider{Providers: []NamedProvider{{Name: "opensky", Provider: &stubProvider{}}}}
+	if _, err := Schedule(context.Background(), plainOnly, "JFK", "LAX", time.Now(), Page{}); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("expected ErrNotSupported without a timetable member, got %v", err)
+	}
+}
+
+type liveStubProvider struct {
+	stubProvider
+	started bool
+	updates chan struct{}
+}
+
+func (s *liveStubProvider) Start(ctx context.Context) { s.started = true }
+
+func (s *liveStubProvider) Updates() <-chan struct{} { return s.updates }
+
+func TestChainStartsLiveMembersAndMergesUpdates(t *testing.T) {
+	first := &liveStubProvider{updates: make(chan struct{})}
+	second := &liveStubProvider{updates: make(chan struct{})}
+	chain := &ChainProvider{Providers: []NamedProvider{
+		{Name: "sbs", Provider: first},
+		{Name: "aviationstack", Provider: &stubProvider{}},
+		{Name: "adsb", Provider: second},
+	}}
+
+	var p FlightProvider = chain
+	live, ok := p.(LiveProvider)
+	if !ok {
+		t.Fatal("expected the chain to be a LiveProvider")
+	}
+	live.Start(context.Background())
+	if !first.started || !second.started {
+		t.Fatal("expected every live member to be started")
+	}
+
+	updates := live.Updates()
+	if again := live.Updates(); again != updates {
+		t.Fatal("expected asking again before any update to return the same channel")
+	}
+	close(second.updates)
+	select {
+	case <-updates:
+	case <-time.After(time.Second):
+		t.Fatal("expected an update from the second live member to be passed on")
+	}
+
+	plain := &ChainProvider{Providers: []NamedProvider{{Name: "aviationstack", Provider: &stubProvider{}}}}
+	if plain.Updates() != nil {
+		t.Fatal("expected no updates from a chain without live members")
+	}
+}
@@ -1,10 +1,65 @@
 package provider
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"net/http"
+	"net/url"
+	"os"
 	"time"
 )
 
 var providerHTTPClient = &http.Client{
 	Timeout: 15 * time.Second,
 }
+
+// httpClient returns the shared client, or a copy that sends requests
+// through transport when a provider sets its own.
+func httpClient(transport http.RoundTripper) *http.Client {
+	if transport == nil {
+		return providerHTTPClient
+	}
+	return &http.Client{
+		Timeout:   providerHTTPClient.Timeout,
+		Transport: transport,
+	}
+}
+
+// NewHTTPTransport returns a copy of the default transport that also trusts
+// the PEM certificates in caFile and sends requests through proxyURL. Either
+// may be empty.
+func NewHTTPTransport(caFile, proxyURL string) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if proxyURL != "" {
+		// The proxy URL may carry credentials, so it is never echoed back.
+		proxy, err := url.Parse(proxyURL)
+		if err != nil || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL: use scheme://host:port")
+		}
+		switch proxy.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return nil, fmt.Errorf("invalid proxy URL: scheme must be http, https or socks5")
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA file: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM certificates found in CA file %s", caFile)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	return transport, nil
+}
@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/airports"
+	"github.com/joshuachuah/flightcli/internal/models"
+)
+
+const openSkyDefaultBaseURL = "https://opensky-network.org/api"
+
+const (
+	defaultOpenSkyWindow        = 12 * time.Hour
+	metersPerSecToMph           = 2.23694
+	metersPerSecToFeetPerMinute = 196.85
+)
+
+// OpenSkyProvider answers lookups from OpenSky Network state vectors and
+// flight records. It needs no API key.
+type OpenSkyProvider struct {
+	// BaseURL is the API root (e.g. "http://localhost:8080/api").
+	// Defaults to the public OpenSky Network API.
+	BaseURL string
+	// Window bounds how far back airport and route lookups search.
+	// Defaults to 12 hours.
+	Window time.Duration
+	// Transport, when set, sends requests instead of the shared client's
+	// transport, e.g. to record or replay them.
+	Transport http.RoundTripper
+}
+
+type openSkyStatesResponse struct {
+	Time   int64               `json:"time"`
+	States [][]json.RawMessage `json:"states"`
+}
+
+// openSkyState is one decoded state vector. OpenSky encodes these as
+// positional arrays; nil pointers are fields the API reported as null.
+type openSkyState struct {
+	ICAO24       string
+	Callsign     string
+	LastContact  int64
+	Longitude    *float64
+	Latitude     *float64
+	BaroAltitude *float64
+	OnGround     bool
+	Velocity     *float64
+	TrueTrack    *float64
+	VerticalRate *float64
+}
+
+type openSkyFlight struct {
+	ICAO24              string `json:"icao24"`
+	FirstSeen           int64  `json:"firstSeen"`
+	EstDepartureAirport string `json:"estDepartureAirport"`
+	LastSeen            int64  `json:"lastSeen"`
+	EstArrivalAirport   string `json:"estArrivalAirport"`
+	Callsign            string `json:"callsign"`
+}
+
+func (o *OpenSkyProvider) GetFlightStatus(ctx context.Context, flightNumber string) (*models.Flight, error) {
+	normalizedFlightNumber := normalizeFlightNumber(strings.ToUpper(strings.TrimSpace(flightNumber)))
+	candidates := callsignCandidates(normalizedFlightNumber)
+
+	var resp openSkyStatesResponse
+	if err := o.get(ctx, "/states/all", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	for _, candidate := range candidates {
+		for _, row := range resp.States {
+			state, ok := openSkyStateFromRow(row)
+			if !ok || state.Callsign != candidate {
+				continue
+			}
+			return flightFromOpenSkyState(state), nil
+		}
+	}
+
+	return nil, notFoundf("no flight found for %s", normalizedFlightNumber)
+}
+
+func (o *OpenSkyProvider) GetAirportFlights(ctx context.Context, airportCode string, flightType string) ([]models.AirportFlight, error) {
+	code := strings.ToUpper(strings.TrimSpace(airportCode))
+	flightType = strings.ToLower(strings.TrimSpace(flightType))
+
+	path := "/flights/departure"
+	if flightType == "arrivals" {
+		path = "/flights/arrival"
+	} else if flightType != "departures" {
+		return nil, fmt.Errorf("invalid flight type %q: must be 'departures' or 'arrivals'", flightType)
+	}
+
+	records, err := o.fetchAirportFlights(ctx, path, code)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, notFoundf("no flights found for airport %s", code)
+	}
+
+	flights := make([]models.AirportFlight, 0, len(records))
+	for _, r := range records {
+		scheduled := unixTime(r.FirstSeen)
+		if flightType == "arrivals" {
+			scheduled = unixTime(r.LastSeen)
+		}
+		flights = append(flights, airportFlightFromOpenSky(r, scheduled))
+	}
+	sortAirportFlights(flights)
+	return flights, nil
+}
+
+func (o *OpenSkyProvider) SearchFlights(ctx context.Context, from, to string) ([]models.AirportFlight, error) {
+	from = strings.ToUpper(strings.TrimSpace(from))
+	to = strings.ToUpper(strings.TrimSpace(to))
+
+	records, err := o.fetchAirportFlights(ctx, "/flights/departure", from)
+	if err != nil {
+		return nil, err
+	}
+
+	arrival := openSkyAirportCode(to)
+	flights := make([]models.AirportFlight, 0, len(records))
+	for _, r := range records {
+		if !strings.EqualFold(strings.TrimSpace(r.EstArrivalAirport), arrival) {
+			continue
+		}
+		flights = append(flights, airportFlightFromOpenSky(r, unixTime(r.FirstSeen)))
+	}
+	if len(flights) == 0 {
+		return nil, notFoundf("no flights found for route %s -> %s", from, to)
+	}
+	sortAirportFlights(flights)
+	return flights, nil
+}
+
+func (o *OpenSkyProvider) fetchAirportFlights(ctx context.Context, path, airportCode string) ([]openSkyFlight, error) {
+	window := o.Window
+	if window <= 0 {
+		window = defaultOpenSkyWindow
+	}
+	end := time.Now()
+	begin := end.Add(-window)
+
+	var records []openSkyFlight
+	err := o.get(ctx, path, url.Values{
+		"airport": []string{openSkyAirportCode(airportCode)},
+		"begin":   []string{strconv.FormatInt(begin.Unix(), 10)},
+		"end":     []string{strconv.FormatInt(end.Unix(), 10)},
+	}, &records)
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (o *OpenSkyProvider) get(ctx context.Context, path string, params url.Values, v interface{}) error {
+	base := strings.TrimRight(o.BaseURL, "/")
+	if base == "" {
+		base = openSkyDefaultBaseURL
+	}
+	endpoint, err := url.Parse(base + path)
+	if err != nil {
+		return fmt.Errorf("invalid OpenSky endpoint: %w", err)
+	}
+	endpoint.RawQuery = params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return fmt.Errorf("building OpenSky request: %w", err)
+	}
+	resp, err := httpClient(o.Transport).Do(req)
+	if err != nil {
+		return redactedErrorf(err, "failed to reach OpenSky API: %s", sanitizedProviderErrorText(err.Error()))
+	}
+	defer resp.Body.Close()
+
+	// OpenSky answers 404 when a flights query matched nothing.
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("OpenSky API returned status %d", resp.StatusCode)
+	}
+
+	body := io.LimitReader(resp.Body, 32<<20)
+	if err := json.NewDecoder(body).Decode(v); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
+
+func openSkyStateFromRow(row []json.RawMessage) (openSkyState, bool) {
+	if len(row) < 11 {
+		return openSkyState{}, false
+	}
+
+	var state openSkyState
+	if json.Unmarshal(row[0], &state.ICAO24) != nil {
+		return openSkyState{}, false
+	}
+	var callsign *string
+	if json.Unmarshal(row[1], &callsign) != nil || callsign == nil {
+		return openSkyState{}, false
+	}
+	state.Callsign = strings.ToUpper(strings.TrimSpace(*callsign))
+
+	_ = json.Unmarshal(row[4], &state.LastContact)
+	_ = json.Unmarshal(row[5], &state.Longitude)
+	_ = json.Unmarshal(row[6], &state.Latitude)
+	_ = json.Unmarshal(row[7], &state.BaroAltitude)
+	_ = json.Unmarshal(row[8], &state.OnGround)
+	_ = json.Unmarshal(row[9], &state.Velocity)
+	_ = json.Unmarshal(row[10], &state.TrueTrack)
+	if len(row) > 11 {
+		_ = json.Unmarshal(row[11], &state.VerticalRate)
+	}
+	return state, true
+}
+
+func flightFromOpenSkyState(state openSkyState) *models.Flight {
+	flightNumber, airline := flightFromCallsign(state.Callsign)
+
+	status := "In Flight"
+	if state.OnGround {
+		status = "On Ground"
+	}
+
+	flight := &models.Flight{
+		FlightNumber: flightNumber,
+		Airline:      airline,
+		Status:       status,
+		Aircraft:     models.Aircraft{ICAO24: strings.ToUpper(state.ICAO24)},
+		OnGround:     state.OnGround,
+	}
+	if state.LastContact > 0 {
+		flight.LiveUpdated = time.Unix(state.LastContact, 0)
+	}
+	if state.Latitude != nil && state.Longitude != nil {
+		flight.Latitude = *state.Latitude
+		flight.Longitude = *state.Longitude
+	}
+	if state.BaroAltitude != nil {
+		flight.Altitude = *state.BaroAltitude * metersToFeet
+	}
+	if state.Velocity != nil {
+		flight.Speed = *state.Velocity * metersPerSecToMph
+	}
+	if state.TrueTrack != nil {
+		flight.Heading = *state.TrueTrack
+	}
+	if state.VerticalRate != nil {
+		flight.VerticalRate = *state.VerticalRate * metersPerSecToFeetPerMinute
+	}
+	return flight
+}
+
+func airportFlightFromOpenSky(r openSkyFlight, scheduled time.Time) models.AirportFlight {
+	flightNumber, airline := flightFromCallsign(r.Callsign)
+	if flightNumber == "" {
+		flightNumber = strings.ToUpper(r.ICAO24)
+	}
+
+	status := "In Flight"
+	arrival := time.Time{}
+	if strings.TrimSpace(r.EstArrivalAirport) != "" && r.LastSeen > 0 {
+		status = "Landed"
+		arrival = unixTime(r.LastSeen)
+	}
+
+	return models.AirportFlight{
+		FlightNumber:  flightNumber,
+		Airline:       airline,
+		Origin:        iataFromOpenSkyAirport(r.EstDepartureAirport),
+		Destination:   iataFromOpenSkyAirport(r.EstArrivalAirport),
+		Status:        status,
+		DepartureTime: unixTime(r.FirstSeen),
+		ArrivalTime:   arrival,
+		ScheduledTime: scheduled,
+	}
+}
+
+// openSkyAirportCode converts a CLI airport code to the ICAO code OpenSky
+// expects, through the airports table. Four-letter codes and codes missing
+// from the table pass through unchanged.
+func openSkyAirportCode(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if a := airports.ByIATA(code); a != nil && a.ICAO != "" {
+		return a.ICAO
+	}
+	return code
+}
+
+// iataFromOpenSkyAirport maps an ICAO airport code from OpenSky back to its
+// IATA code, or returns it unchanged when the airports table lacks one.
+func iataFromOpenSkyAirport(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if a := airports.ByICAO(code); a != nil && a.IATA != "" {
+		return a.IATA
+	}
+	return code
+}
+
+func unixTime(seconds int64) time.Time {
+	if seconds <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
+}
+
+func sortAirportFlights(flights []models.AirportFlight) {
+	sort.SliceStable(flights, func(i, j int) bool {
+		return flights[i].ScheduledTime.Before(flights[j].ScheduledTime)
+	})
+}
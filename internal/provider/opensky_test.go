@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newOpenSkyTestServer(t *testing.T, handler http.HandlerFunc) *OpenSkyProvider {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return &OpenSkyProvider{BaseURL: server.URL + "/api"}
+}
+
+func TestOpenSkyGetFlightStatusMatchesICAOCallsign(t *testing.T) {
+	provider := newOpenSkyTestServer(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/states/all" {
+			t.Fatalf("unexpected path %q", req.URL.Path)
+		}
+		fmt.Fprint(w, `{"time":1773400000,"states":[
+			["abc123","DAL200  ","United States",1773400000,1773400000,-118.4,33.9,3000,false,150,90,0,null,3100,"1200",false,0],
+			["a1b2c3","UAL2189 ","United States",1773400000,1773400000,-73.9352,40.7128,10515.6,false,230.2,274.5,-5.08,null,10600,"1200",false,0]
+		]}`)
+	})
+
+	flight, err := provider.GetFlightStatus(context.Background(), "UA2189")
+	if err != nil {
+		t.Fatalf("GetFlightStatus returned error: %v", err)
+	}
+	if flight.FlightNumber != "UA2189" {
+		t.Fatalf("expected IATA flight number UA2189, got %q", flight.FlightNumber)
+	}
+	if flight.Airline != "United Airlines" {
+		t.Fatalf("expected airline from ICAO table, got %q", flight.Airline)
+	}
+	if flight.Status != "In Flight" {
+		t.Fatalf("expected airborne state to be In Flight, got %q", flight.Status)
+	}
+	if flight.Latitude != 40.7128 || flight.Longitude != -73.9352 {
+		t.Fatalf("unexpected position %v, %v", flight.Latitude, flight.Longitude)
+	}
+	if flight.Altitude < 34499 || flight.Altitude > 34501 {
+		t.Fatalf("expected altitude converted to feet, got %v", flight.Altitude)
+	}
+	if flight.Speed < 514 || flight.Speed > 516 {
+		t.Fatalf("expected speed converted to mph, got %v", flight.Speed)
+	}
+	if flight.VerticalRate > -999 || flight.VerticalRate < -1001 {
+		t.Fatalf("expected vertical rate converted to ft/min, got %v", flight.VerticalRate)
+	}
+	if !flight.LiveUpdated.Equal(time.Unix(1773400000, 0)) {
+		t.Fatalf("expected last contact as the live timestamp, got %v", flight.LiveUpdated)
+	}
+}
+
+func TestOpenSkyGetFlightStatusSkipsNullCallsigns(t *testing.T) {
+	provider := newOpenSkyTestServer(t, func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"time":1773400000,"states":[["abc123",null,"United States",null,1773400000,null,null,null,true,null,null,null,null,null,null,false,0]]}`)
+	})
+
+	if _, err := provider.GetFlightStatus(context.Background(), "AA100"); err == nil {
+		t.Fatal("expected missing flight to return an error")
+	}
+}
+
+func TestOpenSkyGetAirportFlightsMapsRecords(t *testing.T) {
+	provider := newOpenSkyTestServer(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/flights/arrival" {
+			t.Fatalf("unexpected path %q", req.URL.Path)
+		}
+		if got := req.URL.Query().Get("airport"); got != "KLAX" {
+			t.Fatalf("expected ICAO airport KLAX, got %q", got)
+		}
+		if req.URL.Query().Get("begin") == "" || req.URL.Query().Get("end") == "" {
+			t.Fatalf("expected begin/end window, got %q", req.URL.RawQuery)
+		}
+		fmt.Fprint(w, `[
+			{"icao24":"a1b2c3","firstSeen":1773390000,"estDepartureAirport":"KJFK","lastSeen":1773400000,"estArrivalAirport":"KLAX","callsign":"AAL100  "},
+			{"icao24":"d4e5f6","firstSeen":1773380000,"estDepartureAirport":"EGLL","lastSeen":1773395000,"estArrivalAirport":"KLAX","callsign":"BAW283  "}
+		]`)
+	})
+
+	flights, err := provider.GetAirportFlights(context.Background(), "lax", "arrivals")
+	if err != nil {
+		t.Fatalf("GetAirportFlights returned error: %v", err)
+	}
+	if len(flights) != 2 {
+		t.Fatalf("expected two flights, got %d", len(flights))
+	}
+	// Sorted by the time the flight was seen at LAX.
+	if flights[0].FlightNumber != "BA283" || flights[0].Origin != "LHR" || flights[0].Destination != "LAX" {
+		t.Fatalf("unexpected first flight %#v", flights[0])
+	}
+	if flights[1].FlightNumber != "AA100" || flights[1].Airline != "American Airlines" || flights[1].Origin != "JFK" {
+		t.Fatalf("unexpected second flight %#v", flights[1])
+	}
+	if flights[1].Status != "Landed" {
+		t.Fatalf("expected completed flight to be Landed, got %q", flights[1].Status)
+	}
+}
+
+func TestOpenSkySearchFlightsFiltersByArrival(t *testing.T) {
+	provider := newOpenSkyTestServer(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/flights/departure" {
+			t.Fatalf("unexpected path %q", req.URL.Path)
+		}
+		fmt.Fprint(w, `[
+			{"icao24":"a1b2c3","firstSeen":1773390000,"estDepartureAirport":"KJFK","lastSeen":1773400000,"estArrivalAirport":"KLAX","callsign":"DAL200"},
+			{"icao24":"d4e5f6","firstSeen":1773380000,"estDepartureAirport":"KJFK","lastSeen":1773395000,"estArrivalAirport":"KSFO","callsign":"UAL15"}
+		]`)
+	})
+
+	flights, err := provider.SearchFlights(context.Background(), "JFK", "LAX")
+	if err != nil {
+		t.Fatalf("SearchFlights returned error: %v", err)
+	}
+	if len(flights) != 1 || flights[0].FlightNumber != "DL200" {
+		t.Fatalf("expected only the JFK -> LAX flight, got %#v", flights)
+	}
+}
+
+func TestOpenSkyMapsNonUSAirportsThroughTheAirportsTable(t *testing.T) {
+	provider := newOpenSkyTestServer(t, func(w http.ResponseWriter, req *http.Request) {
+		if got := req.URL.Query().Get("airport"); got != "EGLL" {
+			t.Fatalf("expected ICAO airport EGLL for LHR, got %q", got)
+		}
+		fmt.Fprint(w, `[
+			{"icao24":"a1b2c3","firstSeen":1773390000,"estDepartureAirport":"EGLL","lastSeen":1773400000,"estArrivalAirport":"BIKF","callsign":"BAW800"},
+			{"icao24":"d4e5f6","firstSeen":1773380000,"estDepartureAirport":"EGLL","lastSeen":1773395000,"estArrivalAirport":"ZZZZ","callsign":"BAW900"}
+		]`)
+	})
+
+	flights, err := provider.GetAirportFlights(context.Background(), "LHR", "departures")
+	if err != nil {
+		t.Fatalf("GetAirportFlights returned error: %v", err)
+	}
+	destinations := map[string]string{}
+	for _, f := range flights {
+		if f.Origin != "LHR" {
+			t.Errorf("expected origin LHR, got %q", f.Origin)
+		}
+		destinations[f.FlightNumber] = f.Destination
+	}
+	if destinations["BA800"] != "KEF" {
+		t.Errorf("expected BIKF to map back to KEF, got %q", destinations["BA800"])
+	}
+	if destinations["BA900"] != "ZZZZ" {
+		t.Errorf("expected an unknown ICAO code to pass through, got %q", destinations["BA900"])
+	}
+}
+
+func TestOpenSkyAirportFlightsTreatsNotFoundAsEmpty(t *testing.T) {
+	provider := newOpenSkyTestServer(t, func(w http.ResponseWriter, req *http.Request) {
+		http.NotFound(w, req)
+	})
+
+	_, err := provider.GetAirportFlights(context.Background(), "JFK", "departures")
+	if err == nil || err.Error() != "no flights found for airport JFK" {
+		t.Fatalf("expected no-flights error, got %v", err)
+	}
+}
+
+func TestCallsignCandidates(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"UA2189", []string{"UAL2189", "UA2189"}},
+		{"ual2189", []string{"UAL2189"}},
+		{"KE038", []string{"KAL38", "KE38"}},
+		{"B6123", []string{"JBU123", "B6123"}},
+		{"AD4101", []string{"PRZ4101", "AD4101"}},
+		{"LH400", []string{"DLH400", "LH400"}},
+	}
+	for _, tt := range tests {
+		got := callsignCandidates(tt.input)
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("callsignCandidates(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestFlightFromCallsign(t *testing.T) {
+	number, airline := flightFromCallsign("BAW0117 ")
+	if number != "BA117" || airline != "British Airways" {
+		t.Fatalf("flightFromCallsign(BAW0117) = %q, %q", number, airline)
+	}
+
+	number, airline = flightFromCallsign("N123AB")
+	if number != "N123AB" || airline != "" {
+		t.Fatalf("expected unknown callsign to pass through, got %q, %q", number, airline)
+	}
+}
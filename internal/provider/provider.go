@@ -2,12 +2,130 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/joshuachuah/flightcli/internal/models"
 )
 
+// ErrNotFound is matched (via errors.Is) by errors reporting that a
+// provider answered but had no matching flights.
+var ErrNotFound = errors.New("no flights found")
+
+// ErrNotSupported is returned (wrapped) by providers that cannot answer a
+// kind of lookup at all, such as airport boards from a local receiver.
+var ErrNotSupported = errors.New("not supported by this provider")
+
 type FlightProvider interface {
 	GetFlightStatus(ctx context.Context, flightNumber string) (*models.Flight, error)
 	GetAirportFlights(ctx context.Context, airportCode string, flightType string) ([]models.AirportFlight, error)
 	SearchFlights(ctx context.Context, from, to string) ([]models.AirportFlight, error)
 }
+
+// Page selects part of a large airport board or route search. The zero
+// value asks for the provider's default single page.
+type Page struct {
+	// Size is the number of flights per request; zero uses the provider's
+	// default.
+	Size int
+	// Number is the 1-based page to start from; zero means the first.
+	Number int
+	// Max keeps requesting further pages until this many flights are
+	// gathered or the results run out. Zero means a single page.
+	Max int
+	// Window keeps requesting further pages until flights scheduled this
+	// far past now turn up, then drops anything later. Zero means no window.
+	Window time.Duration
+}
+
+// Options narrows a lookup beyond its required arguments. The zero value
+// asks for today's flights and the provider's default page.
+type Options struct {
+	// Date asks for flights on that calendar day instead of today. Only
+	// its year, month and day are used.
+	Date time.Time
+	// Page selects part of a large airport board or route search.
+	Page Page
+}
+
+// OptionsProvider is implemented by providers that can honor Options, such
+// as looking up a past date or paging through a large board.
+type OptionsProvider interface {
+	FlightProvider
+	GetFlightStatusWithOptions(ctx context.Context, flightNumber string, opts Options) (*models.Flight, error)
+	GetAirportFlightsWithOptions(ctx context.Context, airportCode string, flightType string, opts Options) ([]models.AirportFlight, error)
+	SearchFlightsWithOptions(ctx context.Context, from, to string, opts Options) ([]models.AirportFlight, error)
+}
+
+// DateLayout is the YYYY-MM-DD form dates are given and shown in.
+const DateLayout = "2006-01-02"
+
+// BeforeToday reports whether date falls on an earlier calendar day than
+// now, in now's time zone.
+func BeforeToday(date, now time.Time) bool {
+	return date.Before(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()))
+}
+
+// StatusWithOptions looks up a flight with opts if p is an OptionsProvider.
+// Other providers only know today's flights, so a Date is not supported;
+// their single page is used whatever opts.Page asks.
+func StatusWithOptions(ctx context.Context, p FlightProvider, flightNumber string, opts Options) (*models.Flight, error) {
+	if op, ok := p.(OptionsProvider); ok {
+		return op.GetFlightStatusWithOptions(ctx, flightNumber, opts)
+	}
+	if !opts.Date.IsZero() {
+		return nil, errDateNotSupported
+	}
+	return p.GetFlightStatus(ctx, flightNumber)
+}
+
+// AirportFlightsWithOptions is StatusWithOptions for airport boards.
+func AirportFlightsWithOptions(ctx context.Context, p FlightProvider, airportCode string, flightType string, opts Options) ([]models.AirportFlight, error) {
+	if op, ok := p.(OptionsProvider); ok {
+		return op.GetAirportFlightsWithOptions(ctx, airportCode, flightType, opts)
+	}
+	if !opts.Date.IsZero() {
+		return nil, errDateNotSupported
+	}
+	return p.GetAirportFlights(ctx, airportCode, flightType)
+}
+
+// SearchWithOptions is StatusWithOptions for route searches.
+func SearchWithOptions(ctx context.Context, p FlightProvider, from, to string, opts Options) ([]models.AirportFlight, error) {
+	if op, ok := p.(OptionsProvider); ok {
+		return op.SearchFlightsWithOptions(ctx, from, to, opts)
+	}
+	if !opts.Date.IsZero() {
+		return nil, errDateNotSupported
+	}
+	return p.SearchFlights(ctx, from, to)
+}
+
+var errDateNotSupported = fmt.Errorf("lookups by date are %w", ErrNotSupported)
+
+// ScheduleProvider is implemented by providers that know airline
+// timetables for future dates.
+type ScheduleProvider interface {
+	GetSchedule(ctx context.Context, from, to string, date time.Time, page Page) ([]models.ScheduledFlight, error)
+}
+
+// Schedule returns p's timetable from one airport to another on date, if p
+// is a ScheduleProvider. page selects which departures are searched.
+func Schedule(ctx context.Context, p FlightProvider, from, to string, date time.Time, page Page) ([]models.ScheduledFlight, error) {
+	if sp, ok := p.(ScheduleProvider); ok {
+		return sp.GetSchedule(ctx, from, to, date, page)
+	}
+	return nil, errScheduleNotSupported
+}
+
+var errScheduleNotSupported = fmt.Errorf("timetables are %w", ErrNotSupported)
+
+// LiveProvider is implemented by providers fed by a continuous stream
+// rather than per-request calls. Updates returns a channel that is closed
+// when new data arrives; call it again after each notification.
+type LiveProvider interface {
+	FlightProvider
+	Start(ctx context.Context)
+	Updates() <-chan struct{}
+}
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Query parameters left out of recording keys: the API key is a secret, and
+// OpenSky's begin/end window moves with the clock so it would never match on
+// replay.
+var unrecordedParams = []string{"access_key", "begin", "end"}
+
+// Response headers worth keeping; the rest vary between runs.
+var recordedHeaders = []string{"Content-Type", "Retry-After"}
+
+// Recording is one request/response pair as stored on disk.
+type Recording struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  url.Values  `json:"query,omitempty"`
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	// Body holds JSON responses as-is so recordings stay readable and
+	// editable. Anything else is kept verbatim in BodyText.
+	Body     json.RawMessage `json:"body,omitempty"`
+	BodyText string          `json:"body_text,omitempty"`
+}
+
+// RecordingTransport sends requests through Next and writes every response
+// to Dir, one JSON file per distinct request. Requests are keyed by method,
+// path and query (minus unrecordedParams), so the host does not matter and
+// recording the same request again replaces the earlier file.
+type RecordingTransport struct {
+	Dir string
+	// Next sends the real request. Defaults to the shared provider client's
+	// transport.
+	Next http.RoundTripper
+
+	mu sync.Mutex
+}
+
+func (t *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.Next
+	if next == nil {
+		next = providerHTTPClient.Transport
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 32<<20))
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("reading response to record: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	rec := Recording{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  recordedQuery(req.URL),
+		Status: resp.StatusCode,
+	}
+	for _, name := range recordedHeaders {
+		if value := resp.Header.Get(name); value != "" {
+			if rec.Header == nil {
+				rec.Header = http.Header{}
+			}
+			rec.Header.Set(name, value)
+		}
+	}
+	if json.Valid(body) {
+		rec.Body = body
+	} else {
+		rec.BodyText = string(body)
+	}
+
+	if err := t.write(recordingFile(t.Dir, req), rec); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (t *RecordingTransport) write(path string, rec Recording) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("creating recording directory: %w", err)
+	}
+	data, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding recording: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("writing recording: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("writing recording: %w", err)
+	}
+	return nil
+}
+
+// ErrNoRecording is returned by ReplayTransport for a request that was
+// never recorded.
+var ErrNoRecording = errors.New("no recording for request")
+
+// ReplayTransport answers requests from files written by RecordingTransport
+// without touching the network.
+type ReplayTransport struct {
+	Dir string
+}
+
+func (t *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	path := recordingFile(t.Dir, req)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s %s in %s", ErrNoRecording, req.Method, describeRequest(req.URL), t.Dir)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading recording: %w", err)
+	}
+
+	var rec Recording
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, fmt.Errorf("parsing recording %s: %w", filepath.Base(path), err)
+	}
+
+	body := []byte(rec.BodyText)
+	if len(rec.Body) > 0 {
+		body = rec.Body
+	}
+	header := rec.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rec.Status, http.StatusText(rec.Status)),
+		StatusCode:    rec.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func recordedQuery(u *url.URL) url.Values {
+	query := u.Query()
+	for _, param := range unrecordedParams {
+		query.Del(param)
+	}
+	if len(query) == 0 {
+		return nil
+	}
+	return query
+}
+
+func describeRequest(u *url.URL) string {
+	query := recordedQuery(u)
+	if len(query) == 0 {
+		return u.Path
+	}
+	return u.Path + "?" + query.Encode()
+}
+
+// recordingFile names a request's recording after its path, plus a hash of
+// the full key so different queries get different files.
+func recordingFile(dir string, req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Method + " " + describeRequest(req.URL)))
+
+	name := strings.ReplaceAll(strings.Trim(req.URL.Path, "/"), "/", "-")
+	if name == "" {
+		name = "root"
+	}
+	return filepath.Join(dir, fmt.Sprintf("%s-%s.json", name, hex.EncodeToString(sum[:6])))
+}
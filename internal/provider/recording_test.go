@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const recordedUA2189 = `{"data":[{"flight_status":"active",
+	"departure":{"airport":"Newark Liberty International","iata":"EWR","timezone":"America/New_York","scheduled":"2026-03-13T08:00:00+00:00"},
+	"arrival":{"airport":"San Francisco International","iata":"SFO","timezone":"America/Los_Angeles","scheduled":"2026-03-13T11:25:00+00:00"},
+	"airline":{"name":"United Airlines","iata":"UA","icao":"UAL"},
+	"flight":{"iata":"UA2189"},
+	"live":{"latitude":41.2,"longitude":-98.4,"altitude":10668,"speed_horizontal":850,"is_ground":false}}]}`
+
+func TestRecordThenReplayAviationStack(t *testing.T) {
+	dir := t.TempDir()
+	upstream := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		recorder := httptest.NewRecorder()
+		recorder.Header().Set("Content-Type", "application/json")
+		if req.URL.Query().Get("flight_iata") == "UA2189" {
+			fmt.Fprint(recorder, recordedUA2189)
+		} else {
+			fmt.Fprint(recorder, `{"data":[]}`)
+		}
+		return recorder.Result(), nil
+	})
+
+	recording := &AviationStackProvider{APIKey: "secret-key", Transport: &RecordingTransport{Dir: dir, Next: upstream}}
+	want, err := recording.GetFlightStatus(context.Background(), "UA2189")
+	if err != nil {
+		t.Fatalf("recording GetFlightStatus returned error: %v", err)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 1 || !strings.HasPrefix(filepath.Base(files[0]), "v1-flights-") {
+		t.Fatalf("expected one v1-flights recording, got %v", files)
+	}
+	data, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatalf("read recording: %v", err)
+	}
+	if strings.Contains(string(data), "secret-key") || strings.Contains(string(data), "access_key") {
+		t.Fatalf("expected access key left out of recording, got %s", data)
+	}
+
+	replay := &AviationStackProvider{APIKey: "another-key", Transport: &ReplayTransport{Dir: dir}}
+	got, err := replay.GetFlightStatus(context.Background(), "ua2189")
+	if err != nil {
+		t.Fatalf("replay GetFlightStatus returned error: %v", err)
+	}
+	if got.FlightNumber != want.FlightNumber || got.Altitude != want.Altitude || got.Speed != want.Speed || !got.DepartureTime.Equal(want.DepartureTime) {
+		t.Fatalf("expected replay to match recording:\n got %#v\nwant %#v", got, want)
+	}
+}
+
+func TestReplayReportsMissingRecording(t *testing.T) {
+	replay := &AviationStackProvider{APIKey: "key", Transport: &ReplayTransport{Dir: t.TempDir()}}
+
+	_, err := replay.GetAirportFlights(context.Background(), "JFK", "departures")
+	if !errors.Is(err, ErrNoRecording) {
+		t.Fatalf("expected ErrNoRecording, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "dep_iata=JFK") {
+		t.Fatalf("expected missing request described, got %q", err)
+	}
+}
+
+func TestReplayCheckedInAviationStackRecording(t *testing.T) {
+	replay := &AviationStackProvider{APIKey: "key", Transport: &ReplayTransport{Dir: filepath.Join("testdata", "replay")}}
+
+	flights, err := replay.SearchFlights(context.Background(), "EWR", "SFO")
+	if err != nil {
+		t.Fatalf("SearchFlights returned error: %v", err)
+	}
+	if len(flights) != 2 {
+		t.Fatalf("expected 2 recorded flights, got %d", len(flights))
+	}
+	if flights[0].FlightNumber != "UA2189" || flights[0].Status != "In Flight" || flights[0].Altitude == 0 {
+		t.Fatalf("unexpected first flight: %#v", flights[0])
+	}
+	if flights[1].FlightNumber != "AS23" || flights[1].Status != "Scheduled" {
+		t.Fatalf("unexpected second flight: %#v", flights[1])
+	}
+}
+
+func TestRecordingKeyIgnoresHostAndOpenSkyWindow(t *testing.T) {
+	a, _ := http.NewRequest(http.MethodGet, "https://opensky-network.org/api/flights/departure?airport=KJFK&begin=1&end=2", nil)
+	b, _ := http.NewRequest(http.MethodGet, "http://localhost:8080/api/flights/departure?end=9&airport=KJFK&begin=8", nil)
+	if recordingFile("dir", a) != recordingFile("dir", b) {
+		t.Fatalf("expected same recording file, got %s and %s", recordingFile("dir", a), recordingFile("dir", b))
+	}
+
+	c, _ := http.NewRequest(http.MethodGet, "https://opensky-network.org/api/flights/departure?airport=KLAX", nil)
+	if recordingFile("dir", a) == recordingFile("dir", c) {
+		t.Fatal("expected different airports to get different recordings")
+	}
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryBackoff = 500 * time.Millisecond
+	maxRetryBackoff     = 30 * time.Second
+)
+
+type retryPolicy struct {
+	name       string
+	maxRetries int
+	backoff    time.Duration
+	logger     *log.Logger
+}
+
+// withRetries calls fetch until it succeeds, fails with an error that is not
+// worth retrying, or runs out of retries. It never sleeps past ctx's
+// deadline, nor longer than maxRetryBackoff for a server's Retry-After: if
+// the next wait would, the last error is returned straight away.
+func withRetries[T any](ctx context.Context, policy retryPolicy, fetch func() (T, error)) (T, error) {
+	for attempt := 0; ; attempt++ {
+		value, err := fetch()
+		if err == nil {
+			if attempt > 0 {
+				policy.debugf("%s request succeeded after %d %s", policy.name, attempt, pluralRetry(attempt))
+			}
+			return value, nil
+		}
+		if attempt >= policy.maxRetries || !retryable(ctx, err) {
+			if attempt > 0 {
+				policy.debugf("%s request failed after %d %s: %v", policy.name, attempt, pluralRetry(attempt), err)
+			}
+			return value, err
+		}
+
+		wait := policy.delay(attempt, err)
+		if wait > maxRetryBackoff {
+			policy.debugf("%s request failed (%v); not retrying, server asked to wait %s", policy.name, err, wait.Round(time.Second))
+			return value, err
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			policy.debugf("%s request failed (%v); not retrying, %s wait would pass the deadline", policy.name, err, wait.Round(time.Millisecond))
+			return value, err
+		}
+		policy.debugf("%s request failed (%v); retry %d/%d in %s", policy.name, err, attempt+1, policy.maxRetries, wait.Round(time.Millisecond))
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return value, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryable reports whether err might go away on its own: network errors,
+// 5xx responses and rate limits. Authentication failures and other 4xx
+// responses never do.
+func retryable(ctx context.Context, err error) bool {
+	// A per-request timeout is worth retrying; the caller giving up is not.
+	if ctx.Err() != nil {
+		return false
+	}
+
+	// A spent monthly quota will not come back by retrying.
+	if errors.Is(err, ErrQuotaExceeded) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return errors.Is(err, ErrRateLimited) || apiErr.StatusCode >= 500
+	}
+
+	var redacted redactedError
+	return errors.As(err, &redacted)
+}
+
+// delay returns how long to wait before retry number attempt+1: the
+// server's Retry-After if it sent one, otherwise exponential backoff with
+// jitter so concurrent clients spread out.
+func (p retryPolicy) delay(attempt int, err error) time.Duration {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter
+	}
+
+	base := p.backoff
+	if base <= 0 {
+		base = defaultRetryBackoff
+	}
+	d := base << attempt
+	if d <= 0 || d > maxRetryBackoff {
+		d = maxRetryBackoff
+	}
+	// Wait between half and all of the exponential delay.
+	return d/2 + rand.N(d/2+1)
+}
+
+func (p retryPolicy) debugf(format string, args ...interface{}) {
+	if p.logger != nil {
+		p.logger.Printf(format, args...)
+	}
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an
+// HTTP date. It returns zero when the header is missing or invalid.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
+
+func pluralRetry(n int) string {
+	if n == 1 {
+		return "retry"
+	}
+	return "retries"
+}
@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/quota"
+	"github.com/joshuachuah/flightcli/internal/ratelimit"
+)
+
+func newRetryTestServer(t *testing.T, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(requests.Add(1))
+		status := statuses[len(statuses)-1]
+		if n <= len(statuses) {
+			status = statuses[n-1]
+		}
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"flight":{"iata":"UA2189"}}]}`)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestFetchFlightsRetriesServerErrors(t *testing.T) {
+	server, requests := newRetryTestServer(t, http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK)
+	var logs bytes.Buffer
+	provider := &AviationStackProvider{
+		APIKey:       "secret-key",
+		Endpoint:     server.URL + "/v1/flights",
+		MaxRetries:   3,
+		RetryBackoff: time.Millisecond,
+		Logger:       log.New(&logs, "", 0),
+	}
+
+	flights, err := provider.fetchFlights(context.Background(), url.Values{})
+	if err != nil {
+		t.Fatalf("fetchFlights returned error: %v", err)
+	}
+	if len(flights) != 1 || requests.Load() != 3 {
+		t.Fatalf("expected success on the third request, got %d flights after %d requests", len(flights), requests.Load())
+	}
+	for _, want := range []string{"retry 1/3", "retry 2/3", "succeeded after 2 retries"} {
+		if !strings.Contains(logs.String(), want) {
+			t.Fatalf("expected debug log to contain %q, got:\n%s", want, logs.String())
+		}
+	}
+	if strings.Contains(logs.String(), "secret-key") {
+		t.Fatalf("expected access key kept out of debug log, got:\n%s", logs.String())
+	}
+}
+
+func TestFetchFlightsGivesUpAfterMaxRetries(t *testing.T) {
+	server, requests := newRetryTestServer(t, http.StatusInternalServerError)
+	provider := &AviationStackProvider{APIKey: "secret-key", Endpoint: server.URL + "/v1/flights", MaxRetries: 2, RetryBackoff: time.Millisecond}
+
+	_, err := provider.fetchFlights(context.Background(), url.Values{})
+	if err == nil || !strings.Contains(err.Error(), "status 500") {
+		t.Fatalf("expected status 500 error, got %v", err)
+	}
+	if requests.Load() != 3 {
+		t.Fatalf("expected 1 request plus 2 retries, got %d", requests.Load())
+	}
+}
+
+func TestFetchFlightsNeverRetriesAuthErrors(t *testing.T) {
+	for _, status := range []int{http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound} {
+		server, requests := newRetryTestServer(t, status)
+		provider := &AviationStackProvider{APIKey: "secret-key", Endpoint: server.URL + "/v1/flights", MaxRetries: 3, RetryBackoff: time.Millisecond}
+
+		if _, err := provider.fetchFlights(context.Background(), url.Values{}); err == nil {
+			t.Fatalf("status %d: expected error", status)
+		}
+		if requests.Load() != 1 {
+			t.Fatalf("status %d: expected no retries, got %d requests", status, requests.Load())
+		}
+	}
+}
+
+func TestFetchFlightsRetriesNetworkErrors(t *testing.T) {
+	var requests int
+	provider := &AviationStackProvider{
+		APIKey:       "secret-key",
+		MaxRetries:   2,
+		RetryBackoff: time.Millisecond,
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			requests++
+			if requests == 1 {
+				return nil, errors.New("connection reset by peer")
+			}
+			recorder := httptest.NewRecorder()
+			fmt.Fprint(recorder, `{"data":[]}`)
+			return recorder.Result(), nil
+		}),
+	}
+
+	if _, err := provider.fetchFlights(context.Background(), url.Values{}); err != nil {
+		t.Fatalf("fetchFlights returned error: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected one retry after the network error, got %d requests", requests)
+	}
+}
+
+func TestFetchFlightsWillNotWaitPastDeadline(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	provider := &AviationStackProvider{APIKey: "secret-key", Endpoint: server.URL + "/v1/flights", MaxRetries: 3}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	_, err := provider.fetchFlights(ctx, url.Values{})
+	if err == nil || !strings.Contains(err.Error(), "status 429") {
+		t.Fatalf("expected status 429 error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected to give up immediately rather than wait for Retry-After, took %s", elapsed)
+	}
+	if requests.Load() != 1 {
+		t.Fatalf("expected a single request, got %d", requests.Load())
+	}
+}
+
+func TestFetchFlightsWillNotWaitForLongRetryAfter(t *testing.T) {
+	for _, retryAfter := range []string{"86400", time.Now().Add(24 * time.Hour).UTC().Format(http.TimeFormat)} {
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.Header().Set("Retry-After", retryAfter)
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+
+		provider := &AviationStackProvider{APIKey: "secret-key", Endpoint: server.URL + "/v1/flights", MaxRetries: 3}
+		start := time.Now()
+		_, err := provider.fetchFlights(context.Background(), url.Values{})
+		server.Close()
+
+		if err == nil || !strings.Contains(err.Error(), "status 429") {
+			t.Fatalf("Retry-After %s: expected status 429 error, got %v", retryAfter, err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Fatalf("Retry-After %s: expected to give up immediately, took %s", retryAfter, elapsed)
+		}
+		if requests.Load() != 1 {
+			t.Fatalf("Retry-After %s: expected a single request, got %d", retryAfter, requests.Load())
+		}
+	}
+}
+
+func TestRetryDelayHonorsRetryAfterAndJittersBackoff(t *testing.T) {
+	policy := retryPolicy{backoff: 100 * time.Millisecond}
+
+	rateLimited := &APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: 7 * time.Second}
+	if got := policy.delay(0, rateLimited); got != 7*time.Second {
+		t.Fatalf("expected Retry-After to be used, got %s", got)
+	}
+
+	serverError := &APIError{StatusCode: http.StatusBadGateway}
+	for attempt, max := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond} {
+		for i := 0; i < 20; i++ {
+			if got := policy.delay(attempt, serverError); got < max/2 || got > max {
+				t.Fatalf("attempt %d: expected delay in [%s, %s], got %s", attempt, max/2, max, got)
+			}
+		}
+	}
+	if got := policy.delay(20, serverError); got > maxRetryBackoff {
+		t.Fatalf("expected delay capped at %s, got %s", maxRetryBackoff, got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 3, 13, 12, 0, 0, 0, time.UTC)
+	tests := map[string]time.Duration{
+		"":                              0,
+		"5":                             5 * time.Second,
+		"-1":                            0,
+		"soon":                          0,
+		"Fri, 13 Mar 2026 12:00:30 GMT": 30 * time.Second,
+		"Fri, 13 Mar 2026 11:59:00 GMT": 0,
+	}
+	for value, want := range tests {
+		if got := parseRetryAfter(value, now); got != want {
+			t.Errorf("parseRetryAfter(%q) = %s, want %s", value, got, want)
+		}
+	}
+}
+
+func TestFetchFlightsRecordsEachAttemptInQuota(t *testing.T) {
+	server, requests := newRetryTestServer(t, http.StatusBadGateway, http.StatusOK)
+	ledger := &quota.Ledger{Path: filepath.Join(t.TempDir(), "usage.jsonl")}
+	provider := &AviationStackProvider{
+		APIKey:       "secret-key",
+		Endpoint:     server.URL + "/v1/flights",
+		MaxRetries:   3,
+		RetryBackoff: time.Millisecond,
+		Quota:        &quota.Budget{Ledger: ledger, Limit: 2, Hard: true},
+	}
+
+	if _, err := provider.fetchFlights(context.Background(), url.Values{}); err != nil {
+		t.Fatalf("fetchFlights returned error: %v", err)
+	}
+	if usage, _ := ledger.Usage(time.Time{}); usage.Calls != 2 {
+		t.Fatalf("expected the retry to be counted, got %d calls", usage.Calls)
+	}
+
+	_, err := provider.fetchFlights(context.Background(), url.Values{})
+	if !errors.Is(err, quota.ErrBudgetExceeded) {
+		t.Fatalf("expected ErrBudgetExceeded, got %v", err)
+	}
+	if requests.Load() != 2 {
+		t.Fatalf("expected the refused call never to reach the API, got %d requests", requests.Load())
+	}
+}
+
+func TestFetchFlightsWaitsForRateLimiter(t *testing.T) {
+	server, requests := newRetryTestServer(t, http.StatusOK)
+	provider := &AviationStackProvider{
+		APIKey:      "secret-key",
+		Endpoint:    server.URL + "/v1/flights",
+		RateLimiter: &ratelimit.Limiter{Path: filepath.Join(t.TempDir(), "bucket.json"), RPM: 1},
+	}
+
+	if _, err := provider.fetchFlights(context.Background(), url.Values{}); err != nil {
+		t.Fatalf("fetchFlights returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := provider.fetchFlights(ctx, url.Values{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected to block until the deadline, got %v", err)
+	}
+	if requests.Load() != 1 {
+		t.Fatalf("expected the rate-limited call never to reach the API, got %d requests", requests.Load())
+	}
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/models"
+	"github.com/joshuachuah/flightcli/internal/sbs"
+)
+
+// DefaultSBSAddr is the BaseStation port dump1090 and readsb listen on.
+const DefaultSBSAddr = "localhost:30003"
+
+const (
+	defaultSBSStaleAfter = 60 * time.Second
+	defaultSBSWarmUp     = 5 * time.Second
+)
+
+// SBSProvider answers flight status lookups from a streaming SBS-1
+// (BaseStation) feed. It keeps an in-memory table of every aircraft heard
+// and implements LiveProvider so callers can redraw as messages arrive.
+type SBSProvider struct {
+	// Addr is the host:port of the feed. Defaults to DefaultSBSAddr.
+	Addr string
+	// StaleAfter ages out aircraft not heard from recently. Defaults to 60s.
+	StaleAfter time.Duration
+	// WarmUp is how long a lookup waits for a flight after the feed starts
+	// before reporting it missing. Defaults to 5s.
+	WarmUp time.Duration
+
+	once    sync.Once
+	table   *sbs.Table
+	started time.Time
+}
+
+// Start connects to the feed in the background. The connection lives until
+// ctx is done. Calling Start more than once has no effect.
+func (s *SBSProvider) Start(ctx context.Context) {
+	s.once.Do(func() {
+		staleAfter := s.StaleAfter
+		if staleAfter <= 0 {
+			staleAfter = defaultSBSStaleAfter
+		}
+		addr := s.Addr
+		if addr == "" {
+			addr = DefaultSBSAddr
+		}
+
+		s.table = sbs.NewTable(staleAfter)
+		s.started = time.Now()
+		go sbs.Follow(ctx, addr, s.table)
+	})
+}
+
+// Updates returns a channel that is closed when the next message arrives.
+func (s *SBSProvider) Updates() <-chan struct{} {
+	s.Start(context.Background())
+	return s.table.Changed()
+}
+
+func (s *SBSProvider) GetFlightStatus(ctx context.Context, flightNumber string) (*models.Flight, error) {
+	normalizedFlightNumber := normalizeFlightNumber(strings.ToUpper(strings.TrimSpace(flightNumber)))
+	candidates := callsignCandidates(normalizedFlightNumber)
+
+	// Lookups outside of track start the feed on demand for the rest of
+	// the process.
+	s.Start(context.Background())
+
+	warmUp := s.WarmUp
+	if warmUp <= 0 {
+		warmUp = defaultSBSWarmUp
+	}
+	deadline := time.NewTimer(time.Until(s.started.Add(warmUp)))
+	defer deadline.Stop()
+
+	for {
+		changed := s.table.Changed()
+		now := time.Now()
+		for _, candidate := range candidates {
+			if aircraft, ok := s.table.ByCallsign(candidate, now); ok {
+				return flightFromSBS(aircraft), nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline.C:
+			return nil, notFoundf("no flight found for %s", normalizedFlightNumber)
+		case <-changed:
+		}
+	}
+}
+
+func (s *SBSProvider) GetAirportFlights(ctx context.Context, airportCode string, flightType string) ([]models.AirportFlight, error) {
+	return nil, fmt.Errorf("airport boards are not available from an SBS-1 feed: %w", ErrNotSupported)
+}
+
+func (s *SBSProvider) SearchFlights(ctx context.Context, from, to string) ([]models.AirportFlight, error) {
+	return nil, fmt.Errorf("route search is not available from an SBS-1 feed: %w", ErrNotSupported)
+}
+
+func flightFromSBS(aircraft sbs.Aircraft) *models.Flight {
+	flightNumber, airline := flightFromCallsign(aircraft.Callsign)
+
+	status := "In Flight"
+	if aircraft.OnGround {
+		status = "On Ground"
+	}
+
+	flight := &models.Flight{
+		FlightNumber: flightNumber,
+		Airline:      airline,
+		Status:       status,
+		Altitude:     aircraft.Altitude,
+		Speed:        aircraft.GroundSpeed * knotsToMph,
+		Heading:      aircraft.Track,
+		Aircraft:     models.Aircraft{ICAO24: aircraft.Hex},
+		VerticalRate: aircraft.VerticalRate,
+		OnGround:     aircraft.OnGround,
+		LiveUpdated:  aircraft.LastSeen,
+	}
+	if aircraft.HasPosition {
+		flight.Latitude = aircraft.Latitude
+		flight.Longitude = aircraft.Longitude
+	}
+	return flight
+}
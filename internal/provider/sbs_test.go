@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/models"
+)
+
+func TestSBSProviderReadsFlightFromFeed(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		fmt.Fprint(conn, "MSG,1,1,1,A1B2C3,1,,,,,BAW117,,,,,,,,,,,\r\n")
+		fmt.Fprint(conn, "MSG,3,1,1,A1B2C3,1,,,,,,36000,,,51.47,-0.4543,,,0,0,0,0\r\n")
+		fmt.Fprint(conn, "MSG,4,1,1,A1B2C3,1,,,,,,,480,92,,,0,,,,,0\r\n")
+		time.Sleep(time.Second)
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	provider := &SBSProvider{Addr: listener.Addr().String(), WarmUp: 3 * time.Second}
+	provider.Start(ctx)
+
+	flight := waitForSBSFlight(t, provider, "BA117")
+	if flight.FlightNumber != "BA117" || flight.Airline != "British Airways" {
+		t.Fatalf("expected callsign mapped to BA117, got %#v", flight)
+	}
+	if flight.Latitude != 51.47 || flight.Heading != 92 || flight.Status != "In Flight" {
+		t.Fatalf("unexpected live fields: %#v", flight)
+	}
+}
+
+// waitForSBSFlight polls until every message the test feed sent has been
+// merged into the provider's table.
+func waitForSBSFlight(t *testing.T, provider *SBSProvider, flightNumber string) *models.Flight {
+	t.Helper()
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		flight, err := provider.GetFlightStatus(context.Background(), flightNumber)
+		if err == nil && flight.Altitude == 36000 && flight.Speed > 0 {
+			return flight
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("flight %s never appeared with complete data", flightNumber)
+	return nil
+}
+
+func TestSBSProviderReportsMissingFlightAfterWarmUp(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	provider := &SBSProvider{Addr: listener.Addr().String(), WarmUp: 50 * time.Millisecond}
+	provider.Start(ctx)
+
+	if _, err := provider.GetFlightStatus(context.Background(), "AA100"); err == nil {
+		t.Fatal("expected unheard flight to return an error")
+	}
+}
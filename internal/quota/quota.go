@@ -0,0 +1,286 @@
+// Package quota keeps a local ledger of AviationStack API calls so the
+// monthly plan allowance can be tracked, warned about and enforced before
+// the API starts refusing requests.
+package quota
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/filelock"
+)
+
+// DefaultMonthlyLimit is the AviationStack free plan's monthly allowance.
+const DefaultMonthlyLimit = 100
+
+// Cache outcomes recorded in Entry.Cache.
+const (
+	CacheHit  = "hit"
+	CacheMiss = "miss"
+)
+
+// ErrBudgetExceeded is returned by Budget.Reserve when a hard limit would
+// be exceeded.
+var ErrBudgetExceeded = errors.New("monthly API call budget exhausted")
+
+// Entry is one ledger line. Misses are calls that reached the API and
+// count against the plan; hits were answered from the local cache.
+type Entry struct {
+	Time       time.Time `json:"time"`
+	Endpoint   string    `json:"endpoint"`
+	ParamsHash string    `json:"params_hash,omitempty"`
+	Cache      string    `json:"cache"`
+}
+
+// Ledger is a JSON-lines file of Entries. Entries from before the current
+// month are dropped as new calls are reserved, so the file stays small.
+type Ledger struct {
+	Path string
+}
+
+// Open returns the ledger at ~/.flightcli/usage.jsonl, creating the
+// directory if needed.
+func Open() (*Ledger, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("could not determine home directory: %w", err)
+	}
+	dir := filepath.Join(home, ".flightcli")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("could not create %s: %w", dir, err)
+	}
+	return &Ledger{Path: filepath.Join(dir, "usage.jsonl")}, nil
+}
+
+// Record appends e to the ledger.
+func (l *Ledger) Record(e Entry) error {
+	unlock, err := l.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return l.append(e)
+}
+
+// lock takes the ledger's lock, shared with other flightcli processes.
+// Appending and pruning both hold it, so no entry is lost to a rewrite.
+func (l *Ledger) lock() (func(), error) {
+	unlock, err := filelock.Acquire(context.Background(), l.Path)
+	if err != nil {
+		return nil, fmt.Errorf("locking usage ledger: %w", err)
+	}
+	return unlock, nil
+}
+
+func (l *Ledger) append(e Entry) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("encoding ledger entry: %w", err)
+	}
+
+	f, err := os.OpenFile(l.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("opening usage ledger: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("writing usage ledger: %w", err)
+	}
+	return nil
+}
+
+// Usage summarizes the ledger from Since onwards.
+type Usage struct {
+	Since     time.Time
+	Calls     int
+	CacheHits int
+	LastCall  time.Time
+}
+
+// Usage reads the ledger and tallies entries at or after since. Corrupt
+// lines are skipped. A missing ledger means no usage.
+func (l *Ledger) Usage(since time.Time) (Usage, error) {
+	usage := Usage{Since: since}
+
+	f, err := os.Open(l.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return usage, nil
+	}
+	if err != nil {
+		return usage, fmt.Errorf("opening usage ledger: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e Entry
+		if json.Unmarshal(scanner.Bytes(), &e) != nil || e.Time.Before(since) {
+			continue
+		}
+		switch e.Cache {
+		case CacheHit:
+			usage.CacheHits++
+		case CacheMiss:
+			usage.Calls++
+			if e.Time.After(usage.LastCall) {
+				usage.LastCall = e.Time
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return usage, fmt.Errorf("reading usage ledger: %w", err)
+	}
+	return usage, nil
+}
+
+// prune rewrites the ledger without entries from before since, and
+// without lines that cannot be parsed. It leaves the file alone when there
+// is nothing to drop. The caller must hold the lock.
+func (l *Ledger) prune(since time.Time) error {
+	data, err := os.ReadFile(l.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading usage ledger: %w", err)
+	}
+
+	var kept []byte
+	dropped := false
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		var e Entry
+		if json.Unmarshal(line, &e) != nil || e.Time.Before(since) {
+			dropped = true
+			continue
+		}
+		kept = append(append(kept, line...), '\n')
+	}
+	if !dropped {
+		return nil
+	}
+
+	tmp := l.Path + ".tmp"
+	if err := os.WriteFile(tmp, kept, 0600); err != nil {
+		return fmt.Errorf("writing usage ledger: %w", err)
+	}
+	if err := os.Rename(tmp, l.Path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("writing usage ledger: %w", err)
+	}
+	return nil
+}
+
+// MonthStart returns midnight on the first of now's month, in now's zone.
+func MonthStart(now time.Time) time.Time {
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+}
+
+// HashParams fingerprints a query without the access key, so the ledger
+// can tell repeated requests apart without storing what was asked.
+func HashParams(params url.Values) string {
+	clean := url.Values{}
+	for key, values := range params {
+		if key != "access_key" {
+			clean[key] = values
+		}
+	}
+	sum := sha256.Sum256([]byte(clean.Encode()))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Budget checks calls against a monthly limit before they are made and
+// records them in a Ledger.
+type Budget struct {
+	Ledger *Ledger
+	// Limit is the plan's monthly call allowance. Zero means unlimited.
+	Limit int
+	// Hard refuses calls beyond Limit instead of warning about them.
+	Hard bool
+	// Warn, when set, is told about calls made beyond Limit.
+	Warn func(message string)
+
+	now func() time.Time
+}
+
+// Reserve records an outbound call to endpoint. Past the limit it warns,
+// or with Hard returns ErrBudgetExceeded without recording anything. The
+// check and the record happen under the ledger's lock, so concurrent
+// processes cannot both take the last call.
+func (b *Budget) Reserve(endpoint string, params url.Values) error {
+	unlock, err := b.Ledger.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	now := b.clock()
+	if err := b.Ledger.prune(MonthStart(now)); err != nil {
+		return err
+	}
+	if b.Limit > 0 {
+		usage, err := b.Ledger.Usage(MonthStart(now))
+		if err != nil {
+			return err
+		}
+		if usage.Calls >= b.Limit {
+			if b.Hard {
+				return fmt.Errorf("%w: %d of %d calls used this month", ErrBudgetExceeded, usage.Calls, b.Limit)
+			}
+			if b.Warn != nil {
+				b.Warn(fmt.Sprintf("this call exceeds your monthly budget (%d of %d calls already used)", usage.Calls, b.Limit))
+			}
+		}
+	}
+
+	return b.Ledger.append(Entry{
+		Time:       now,
+		Endpoint:   endpoint,
+		ParamsHash: HashParams(params),
+		Cache:      CacheMiss,
+	})
+}
+
+// RecordCacheHit notes a lookup the cache answered without an API call.
+// Ledger errors are ignored; losing a hit only affects statistics.
+func (b *Budget) RecordCacheHit(key string) {
+	sum := sha256.Sum256([]byte(key))
+	_ = b.Ledger.Record(Entry{
+		Time:       b.clock(),
+		Endpoint:   "cache",
+		ParamsHash: hex.EncodeToString(sum[:8]),
+		Cache:      CacheHit,
+	})
+}
+
+// Remaining returns the calls left this month, and false when there is no
+// limit. It never goes below zero.
+func (b *Budget) Remaining() (int, bool, error) {
+	if b.Limit <= 0 {
+		return 0, false, nil
+	}
+	usage, err := b.Ledger.Usage(MonthStart(b.clock()))
+	if err != nil {
+		return 0, true, err
+	}
+	return max(b.Limit-usage.Calls, 0), true, nil
+}
+
+func (b *Budget) clock() time.Time {
+	if b.now != nil {
+		return b.now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,164 @@
+package quota
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestBudget(t *testing.T, limit int, hard bool, now time.Time) (*Budget, *[]string) {
+	t.Helper()
+	var warnings []string
+	b := &Budget{
+		Ledger: &Ledger{Path: filepath.Join(t.TempDir(), "usage.jsonl")},
+		Limit:  limit,
+		Hard:   hard,
+		Warn:   func(message string) { warnings = append(warnings, message) },
+		now:    func() time.Time { return now },
+	}
+	return b, &warnings
+}
+
+func TestUsageCountsOnlyThisMonth(t *testing.T) {
+	ledger := &Ledger{Path: filepath.Join(t.TempDir(), "usage.jsonl")}
+	now := time.Date(2026, 3, 13, 12, 0, 0, 0, time.UTC)
+	entries := []Entry{
+		{Time: now.AddDate(0, -1, 0), Endpoint: "/v1/flights", Cache: CacheMiss},
+		{Time: now.Add(-2 * time.Hour), Endpoint: "/v1/flights", Cache: CacheMiss},
+		{Time: now.Add(-time.Hour), Endpoint: "/v1/flights", Cache: CacheMiss},
+		{Time: now, Endpoint: "cache", Cache: CacheHit},
+	}
+	for _, e := range entries {
+		if err := ledger.Record(e); err != nil {
+			t.Fatalf("Record returned error: %v", err)
+		}
+	}
+
+	usage, err := ledger.Usage(MonthStart(now))
+	if err != nil {
+		t.Fatalf("Usage returned error: %v", err)
+	}
+	if usage.Calls != 2 || usage.CacheHits != 1 || !usage.LastCall.Equal(now.Add(-time.Hour)) {
+		t.Fatalf("unexpected usage: %#v", usage)
+	}
+}
+
+func TestUsageOfMissingLedgerIsEmpty(t *testing.T) {
+	ledger := &Ledger{Path: filepath.Join(t.TempDir(), "usage.jsonl")}
+	usage, err := ledger.Usage(time.Time{})
+	if err != nil || usage.Calls != 0 {
+		t.Fatalf("expected empty usage, got %#v, %v", usage, err)
+	}
+}
+
+func TestReserveWarnsPastLimit(t *testing.T) {
+	b, warnings := newTestBudget(t, 2, false, time.Date(2026, 3, 13, 12, 0, 0, 0, time.UTC))
+
+	for i := 0; i < 3; i++ {
+		if err := b.Reserve("/v1/flights", url.Values{}); err != nil {
+			t.Fatalf("Reserve %d returned error: %v", i+1, err)
+		}
+	}
+	if len(*warnings) != 1 || !strings.Contains((*warnings)[0], "2 of 2") {
+		t.Fatalf("expected one warning for the third call, got %q", *warnings)
+	}
+	if remaining, limited, _ := b.Remaining(); remaining != 0 || !limited {
+		t.Fatalf("expected 0 calls remaining, got %d (limited %v)", remaining, limited)
+	}
+}
+
+func TestReserveRefusesPastHardLimit(t *testing.T) {
+	b, warnings := newTestBudget(t, 1, true, time.Date(2026, 3, 13, 12, 0, 0, 0, time.UTC))
+
+	if err := b.Reserve("/v1/flights", url.Values{}); err != nil {
+		t.Fatalf("first Reserve returned error: %v", err)
+	}
+	if err := b.Reserve("/v1/flights", url.Values{}); !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("expected ErrBudgetExceeded, got %v", err)
+	}
+	usage, _ := b.Ledger.Usage(time.Time{})
+	if usage.Calls != 1 || len(*warnings) != 0 {
+		t.Fatalf("expected the refused call to go unrecorded, got %d calls and warnings %q", usage.Calls, *warnings)
+	}
+}
+
+func TestConcurrentReservesShareTheHardLimit(t *testing.T) {
+	now := time.Date(2026, 3, 13, 12, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "usage.jsonl")
+
+	// Separate Budgets and Ledgers stand in for separate processes.
+	var wg sync.WaitGroup
+	var allowed atomic.Int32
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			b := &Budget{Ledger: &Ledger{Path: path}, Limit: 3, Hard: true, now: func() time.Time { return now }}
+			switch err := b.Reserve("/v1/flights", url.Values{}); {
+			case err == nil:
+				allowed.Add(1)
+			case !errors.Is(err, ErrBudgetExceeded):
+				t.Errorf("Reserve returned error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if allowed.Load() != 3 {
+		t.Fatalf("expected exactly 3 calls to be allowed, got %d", allowed.Load())
+	}
+}
+
+func TestReserveDropsEntriesFromEarlierMonths(t *testing.T) {
+	now := time.Date(2026, 3, 13, 12, 0, 0, 0, time.UTC)
+	b, _ := newTestBudget(t, 0, false, now)
+	for _, e := range []Entry{
+		{Time: now.AddDate(0, -2, 0), Endpoint: "/v1/flights", Cache: CacheMiss},
+		{Time: now.AddDate(0, -1, 0), Endpoint: "cache", Cache: CacheHit},
+		{Time: now.Add(-time.Hour), Endpoint: "/v1/flights", Cache: CacheMiss},
+	} {
+		if err := b.Ledger.Record(e); err != nil {
+			t.Fatalf("Record returned error: %v", err)
+		}
+	}
+
+	if err := b.Reserve("/v1/flights", url.Values{}); err != nil {
+		t.Fatalf("Reserve returned error: %v", err)
+	}
+	data, err := os.ReadFile(b.Ledger.Path)
+	if err != nil {
+		t.Fatalf("reading ledger: %v", err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines != 2 {
+		t.Fatalf("expected only this month's 2 entries to be kept, got %d lines:\n%s", lines, data)
+	}
+	usage, _ := b.Ledger.Usage(time.Time{})
+	if usage.Calls != 2 || usage.CacheHits != 0 {
+		t.Fatalf("unexpected usage after pruning: %#v", usage)
+	}
+}
+
+func TestRemainingWithoutLimit(t *testing.T) {
+	b, _ := newTestBudget(t, 0, true, time.Now())
+	if err := b.Reserve("/v1/flights", url.Values{}); err != nil {
+		t.Fatalf("Reserve returned error: %v", err)
+	}
+	if _, limited, _ := b.Remaining(); limited {
+		t.Fatal("expected no limit")
+	}
+}
+
+func TestHashParamsIgnoresAccessKey(t *testing.T) {
+	a := HashParams(url.Values{"access_key": {"one"}, "flight_iata": {"UA2189"}})
+	b := HashParams(url.Values{"access_key": {"two"}, "flight_iata": {"UA2189"}})
+	c := HashParams(url.Values{"flight_iata": {"AA100"}})
+	if a != b || a == c {
+		t.Fatalf("expected hash to depend on params but not the key: %s %s %s", a, b, c)
+	}
+}
@@ -0,0 +1,160 @@
+// Package ratelimit paces API requests with a token bucket whose state is
+// kept in a file, so every flightcli process using the same API key shares
+// one requests-per-minute budget.
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/joshuachuah/flightcli/internal/filelock"
+)
+
+// DefaultRPM is the requests-per-minute budget used when none is configured.
+const DefaultRPM = 60
+
+// Limiter is a token bucket of Burst tokens refilled at RPM per minute,
+// stored at Path. Limiters with the same Path share tokens, whether they
+// live in one process or several.
+type Limiter struct {
+	Path string
+	// RPM is the sustained requests-per-minute rate. It must be positive.
+	RPM int
+	// Burst is how many requests may be sent back to back after a quiet
+	// spell. Zero means RPM.
+	Burst int
+
+	now func() time.Time
+}
+
+// state is the bucket as stored on disk.
+type state struct {
+	Tokens  float64   `json:"tokens"`
+	Updated time.Time `json:"updated"`
+}
+
+// Open returns a limiter for apiKey under ~/.flightcli/. The file name is
+// derived from a hash of the key so that processes sharing a key share a
+// bucket without the key being written to disk.
+func Open(apiKey string, rpm int) (*Limiter, error) {
+	if rpm <= 0 {
+		return nil, fmt.Errorf("rate limit must be positive, got %d requests per minute", rpm)
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("could not determine home directory: %w", err)
+	}
+	dir := filepath.Join(home, ".flightcli")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("could not create %s: %w", dir, err)
+	}
+	sum := sha256.Sum256([]byte(apiKey))
+	name := "ratelimit-" + hex.EncodeToString(sum[:6]) + ".json"
+	return &Limiter{Path: filepath.Join(dir, name), RPM: rpm}, nil
+}
+
+// Wait blocks until a request may be sent, taking one token. It returns
+// the context's error if ctx is done first, leaving the bucket untouched.
+func (l *Limiter) Wait(ctx context.Context) error {
+	for {
+		wait, err := l.take(ctx)
+		if err != nil || wait == 0 {
+			return err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// take claims a token if one is available and otherwise returns how long
+// until the next one is due. Another process may claim that token first,
+// so callers must try again rather than assume it is theirs.
+func (l *Limiter) take(ctx context.Context) (time.Duration, error) {
+	unlock, err := l.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	now := l.clock()
+	burst := float64(l.burst())
+	perSecond := float64(l.RPM) / 60
+
+	s, ok := l.read()
+	if !ok || s.Updated.After(now) {
+		s = state{Tokens: burst, Updated: now}
+	}
+	s.Tokens = min(burst, s.Tokens+now.Sub(s.Updated).Seconds()*perSecond)
+	s.Updated = now
+
+	if s.Tokens < 1 {
+		return time.Duration((1 - s.Tokens) / perSecond * float64(time.Second)), nil
+	}
+	s.Tokens--
+	return 0, l.write(s)
+}
+
+// lock takes the lock on the bucket file, shared with other processes.
+func (l *Limiter) lock(ctx context.Context) (func(), error) {
+	unlock, err := filelock.Acquire(ctx, l.Path)
+	if err != nil && ctx.Err() == nil {
+		return nil, fmt.Errorf("locking rate limit state: %w", err)
+	}
+	return unlock, err
+}
+
+// read returns the stored bucket, or false if there is none or it cannot
+// be parsed; either way the bucket starts full.
+func (l *Limiter) read() (state, bool) {
+	data, err := os.ReadFile(l.Path)
+	if err != nil {
+		return state{}, false
+	}
+	var s state
+	if json.Unmarshal(data, &s) != nil {
+		return state{}, false
+	}
+	return s, true
+}
+
+func (l *Limiter) write(s state) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("encoding rate limit state: %w", err)
+	}
+	tmp := l.Path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("writing rate limit state: %w", err)
+	}
+	if err := os.Rename(tmp, l.Path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("writing rate limit state: %w", err)
+	}
+	return nil
+}
+
+func (l *Limiter) burst() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return l.RPM
+}
+
+func (l *Limiter) clock() time.Time {
+	if l.now != nil {
+		return l.now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,124 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWaitAllowsBurstThenBlocks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bucket.json")
+	l := &Limiter{Path: path, RPM: 600, Burst: 3}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("Wait %d returned error: %v", i+1, err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Fatalf("expected the burst to go through immediately, took %s", elapsed)
+	}
+
+	// 600 RPM refills a token every 100ms.
+	start = time.Now()
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait returned error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Fatalf("expected to wait for a token after the burst, took %s", elapsed)
+	}
+}
+
+func TestWaitReturnsWhenContextEnds(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bucket.json")
+	l := &Limiter{Path: path, RPM: 1, Burst: 1}
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected Wait to stop with the context, took %s", elapsed)
+	}
+}
+
+func TestLimitersSharingAFileShareTokens(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bucket.json")
+	now := time.Date(2026, 3, 13, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	granted := 0
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			l := &Limiter{Path: path, RPM: 5, now: clock}
+			if wait, err := l.take(context.Background()); err == nil && wait == 0 {
+				mu.Lock()
+				granted++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if granted != 5 {
+		t.Fatalf("expected exactly 5 of 8 requests let through, got %d", granted)
+	}
+}
+
+func TestTakeReportsTimeUntilNextToken(t *testing.T) {
+	now := time.Date(2026, 3, 13, 12, 0, 0, 0, time.UTC)
+	l := &Limiter{Path: filepath.Join(t.TempDir(), "bucket.json"), RPM: 30, Burst: 1, now: func() time.Time { return now }}
+
+	if wait, err := l.take(context.Background()); err != nil || wait != 0 {
+		t.Fatalf("expected first token immediately, got %s, %v", wait, err)
+	}
+	if wait, _ := l.take(context.Background()); wait != 2*time.Second {
+		t.Fatalf("expected 2s until the next token at 30 RPM, got %s", wait)
+	}
+	now = now.Add(2 * time.Second)
+	if wait, _ := l.take(context.Background()); wait != 0 {
+		t.Fatalf("expected a token after 2s, got wait %s", wait)
+	}
+}
+
+func TestStaleLockIsBroken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bucket.json")
+	lockPath := path + ".lock"
+	if err := os.WriteFile(lockPath, nil, 0600); err != nil {
+		t.Fatalf("write lock: %v", err)
+	}
+	old := time.Now().Add(-time.Minute)
+	if err := os.Chtimes(lockPath, old, old); err != nil {
+		t.Fatalf("age lock: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	l := &Limiter{Path: path, RPM: 60}
+	if err := l.Wait(ctx); err != nil {
+		t.Fatalf("expected stale lock to be broken, got %v", err)
+	}
+	if _, err := os.Stat(lockPath); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected lock released after Wait, got %v", err)
+	}
+}
+
+func TestOpenRejectsNonPositiveRate(t *testing.T) {
+	if _, err := Open("key", 0); err == nil {
+		t.Fatal("expected an error for a zero rate")
+	}
+}
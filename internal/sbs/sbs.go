@@ -0,0 +1,293 @@
+// Package sbs parses SBS-1 (BaseStation) messages, the CSV feed dump1090
+// and similar receivers serve on TCP port 30003, and keeps a live table of
+// the aircraft they describe.
+package sbs
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Message is one parsed "MSG" line. Only the fields present on the line
+// are set; the pointer fields are nil when the column was empty.
+type Message struct {
+	TransmissionType int
+	Hex              string
+	Callsign         string
+	Altitude         *float64 // feet
+	GroundSpeed      *float64 // knots
+	Track            *float64 // degrees
+	Latitude         *float64
+	Longitude        *float64
+	VerticalRate     *float64 // feet per minute
+	OnGround         *bool
+}
+
+// SBS-1 column positions (0-based).
+const (
+	colMessageType      = 0
+	colTransmissionType = 1
+	colHex              = 4
+	colCallsign         = 10
+	colAltitude         = 11
+	colGroundSpeed      = 12
+	colTrack            = 13
+	colLatitude         = 14
+	colLongitude        = 15
+	colVerticalRate     = 16
+	colOnGround         = 21
+)
+
+// ParseLine parses a single SBS-1 line. Only MSG lines with transmission
+// types 1-8 are accepted.
+func ParseLine(line string) (Message, error) {
+	fields := strings.Split(strings.TrimRight(line, "\r\n"), ",")
+	if len(fields) < 11 || fields[colMessageType] != "MSG" {
+		return Message{}, fmt.Errorf("not an SBS-1 MSG line")
+	}
+
+	transmissionType, err := strconv.Atoi(strings.TrimSpace(fields[colTransmissionType]))
+	if err != nil || transmissionType < 1 || transmissionType > 8 {
+		return Message{}, fmt.Errorf("invalid transmission type %q", fields[colTransmissionType])
+	}
+
+	hex := strings.ToUpper(strings.TrimSpace(fields[colHex]))
+	if hex == "" {
+		return Message{}, fmt.Errorf("missing ICAO hex ident")
+	}
+
+	msg := Message{
+		TransmissionType: transmissionType,
+		Hex:              hex,
+		Callsign:         strings.ToUpper(strings.TrimSpace(field(fields, colCallsign))),
+		Altitude:         parseFloat(field(fields, colAltitude)),
+		GroundSpeed:      parseFloat(field(fields, colGroundSpeed)),
+		Track:            parseFloat(field(fields, colTrack)),
+		Latitude:         parseFloat(field(fields, colLatitude)),
+		Longitude:        parseFloat(field(fields, colLongitude)),
+		VerticalRate:     parseFloat(field(fields, colVerticalRate)),
+	}
+	switch strings.TrimSpace(field(fields, colOnGround)) {
+	case "-1", "1":
+		onGround := true
+		msg.OnGround = &onGround
+	case "0":
+		onGround := false
+		msg.OnGround = &onGround
+	}
+	return msg, nil
+}
+
+func field(fields []string, i int) string {
+	if i < len(fields) {
+		return fields[i]
+	}
+	return ""
+}
+
+func parseFloat(s string) *float64 {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
+
+// Aircraft is the merged state of every message seen for one ICAO hex.
+type Aircraft struct {
+	Hex          string
+	Callsign     string
+	Altitude     float64
+	GroundSpeed  float64
+	Track        float64
+	Latitude     float64
+	Longitude    float64
+	VerticalRate float64
+	OnGround     bool
+	HasPosition  bool
+	LastSeen     time.Time
+}
+
+// Table is a concurrency-safe set of aircraft keyed by ICAO hex. Aircraft
+// not heard from within StaleAfter are ignored by lookups and removed by
+// Prune.
+type Table struct {
+	StaleAfter time.Duration
+
+	mu       sync.Mutex
+	aircraft map[string]*Aircraft
+	changed  chan struct{}
+}
+
+// NewTable returns an empty table that ages out aircraft after staleAfter.
+func NewTable(staleAfter time.Duration) *Table {
+	return &Table{
+		StaleAfter: staleAfter,
+		aircraft:   make(map[string]*Aircraft),
+		changed:    make(chan struct{}),
+	}
+}
+
+// Apply merges a message into the table and wakes Changed waiters.
+func (t *Table) Apply(msg Message, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	a, ok := t.aircraft[msg.Hex]
+	if !ok {
+		a = &Aircraft{Hex: msg.Hex}
+		t.aircraft[msg.Hex] = a
+	}
+	if msg.Callsign != "" {
+		a.Callsign = msg.Callsign
+	}
+	if msg.Altitude != nil {
+		a.Altitude = *msg.Altitude
+	}
+	if msg.GroundSpeed != nil {
+		a.GroundSpeed = *msg.GroundSpeed
+	}
+	if msg.Track != nil {
+		a.Track = *msg.Track
+	}
+	if msg.Latitude != nil && msg.Longitude != nil {
+		a.Latitude = *msg.Latitude
+		a.Longitude = *msg.Longitude
+		a.HasPosition = true
+	}
+	if msg.VerticalRate != nil {
+		a.VerticalRate = *msg.VerticalRate
+	}
+	if msg.OnGround != nil {
+		a.OnGround = *msg.OnGround
+	}
+	a.LastSeen = now
+
+	close(t.changed)
+	t.changed = make(chan struct{})
+}
+
+// Changed returns a channel that is closed the next time the table is
+// updated. Call it again after each notification.
+func (t *Table) Changed() <-chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.changed
+}
+
+// ByCallsign returns the freshest non-stale aircraft broadcasting callsign.
+func (t *Table) ByCallsign(callsign string, now time.Time) (Aircraft, bool) {
+	callsign = strings.ToUpper(strings.TrimSpace(callsign))
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var (
+		best  Aircraft
+		found bool
+	)
+	for _, a := range t.aircraft {
+		if a.Callsign != callsign || t.isStale(a, now) {
+			continue
+		}
+		if !found || a.LastSeen.After(best.LastSeen) {
+			best = *a
+			found = true
+		}
+	}
+	return best, found
+}
+
+// Len returns the number of aircraft currently in the table.
+func (t *Table) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.aircraft)
+}
+
+// Prune removes stale aircraft and returns how many were removed.
+func (t *Table) Prune(now time.Time) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	removed := 0
+	for hex, a := range t.aircraft {
+		if t.isStale(a, now) {
+			delete(t.aircraft, hex)
+			removed++
+		}
+	}
+	return removed
+}
+
+func (t *Table) isStale(a *Aircraft, now time.Time) bool {
+	return t.StaleAfter > 0 && now.Sub(a.LastSeen) > t.StaleAfter
+}
+
+// Read applies every valid line from r to the table until r is exhausted
+// or ctx is done. Unparseable lines are skipped.
+func Read(ctx context.Context, r io.Reader, table *Table) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		msg, err := ParseLine(scanner.Text())
+		if err != nil {
+			continue
+		}
+		table.Apply(msg, time.Now())
+	}
+	return scanner.Err()
+}
+
+// Follow connects to an SBS-1 feed at addr and keeps the table updated
+// until ctx is done, reconnecting with backoff when the connection drops.
+// Stale aircraft are pruned while it runs.
+func Follow(ctx context.Context, addr string, table *Table) {
+	go func() {
+		ticker := time.NewTicker(10 * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				table.Prune(now)
+			}
+		}
+	}()
+
+	backoff := time.Second
+	var dialer net.Dialer
+	for ctx.Err() == nil {
+		conn, err := dialer.DialContext(ctx, "tcp", addr)
+		if err == nil {
+			backoff = time.Second
+			stop := context.AfterFunc(ctx, func() { conn.Close() })
+			_ = Read(ctx, conn, table)
+			stop()
+			conn.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff < 30*time.Second {
+			backoff *= 2
+		}
+	}
+}
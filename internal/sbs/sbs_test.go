@@ -0,0 +1,120 @@
+package sbs
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseLineAirbornePosition(t *testing.T) {
+	msg, err := ParseLine("MSG,3,1,1,a1b2c3,1,2026/04/01,12:00:00.000,2026/04/01,12:00:00.000,,35000,,,40.7128,-73.9352,,,0,0,0,0\r\n")
+	if err != nil {
+		t.Fatalf("ParseLine returned error: %v", err)
+	}
+	if msg.TransmissionType != 3 || msg.Hex != "A1B2C3" {
+		t.Fatalf("unexpected header fields: %#v", msg)
+	}
+	if msg.Altitude == nil || *msg.Altitude != 35000 {
+		t.Fatalf("expected altitude 35000, got %v", msg.Altitude)
+	}
+	if msg.Latitude == nil || msg.Longitude == nil || *msg.Latitude != 40.7128 || *msg.Longitude != -73.9352 {
+		t.Fatalf("unexpected position: %v, %v", msg.Latitude, msg.Longitude)
+	}
+	if msg.GroundSpeed != nil || msg.Track != nil {
+		t.Fatalf("expected empty columns to stay nil: %#v", msg)
+	}
+	if msg.OnGround == nil || *msg.OnGround {
+		t.Fatalf("expected airborne flag, got %v", msg.OnGround)
+	}
+}
+
+func TestParseLineIdentificationAndVelocity(t *testing.T) {
+	msg, err := ParseLine("MSG,1,1,1,A1B2C3,1,2026/04/01,12:00:00.000,2026/04/01,12:00:00.000,UAL2189 ,,,,,,,,,,,")
+	if err != nil {
+		t.Fatalf("ParseLine returned error: %v", err)
+	}
+	if msg.Callsign != "UAL2189" {
+		t.Fatalf("expected trimmed callsign, got %q", msg.Callsign)
+	}
+
+	msg, err = ParseLine("MSG,4,1,1,A1B2C3,1,2026/04/01,12:00:00.000,2026/04/01,12:00:00.000,,,450,274.5,,,-1792,,,,,-1")
+	if err != nil {
+		t.Fatalf("ParseLine returned error: %v", err)
+	}
+	if *msg.GroundSpeed != 450 || *msg.Track != 274.5 || *msg.VerticalRate != -1792 {
+		t.Fatalf("unexpected velocity fields: %#v", msg)
+	}
+	if msg.OnGround == nil || !*msg.OnGround {
+		t.Fatalf("expected -1 to mean on ground")
+	}
+}
+
+func TestParseLineRejectsInvalidLines(t *testing.T) {
+	for _, line := range []string{
+		"",
+		"SEL,,496,2286,4CA4E5,27215,2010/02/19,18:06:07.710,2010/02/19,18:06:07.710,RYR1427",
+		"MSG,9,1,1,A1B2C3,1,2026/04/01,12:00:00.000,2026/04/01,12:00:00.000,,,,,,,,,,,,",
+		"MSG,3,1,1,,1,2026/04/01,12:00:00.000,2026/04/01,12:00:00.000,,,,,,,,,,,,",
+	} {
+		if _, err := ParseLine(line); err == nil {
+			t.Errorf("expected ParseLine(%q) to fail", line)
+		}
+	}
+}
+
+func TestTableMergesMessagesAndAgesOut(t *testing.T) {
+	table := NewTable(time.Minute)
+	start := time.Date(2026, time.April, 1, 12, 0, 0, 0, time.UTC)
+
+	changed := table.Changed()
+	for i, line := range []string{
+		"MSG,1,1,1,A1B2C3,1,,,,,UAL2189,,,,,,,,,,,",
+		"MSG,3,1,1,A1B2C3,1,,,,,,35000,,,40.7,-73.9,,,0,0,0,0",
+		"MSG,4,1,1,A1B2C3,1,,,,,,,450,274,,,1800,,,,,0",
+	} {
+		msg, err := ParseLine(line)
+		if err != nil {
+			t.Fatalf("ParseLine(%d) returned error: %v", i, err)
+		}
+		table.Apply(msg, start.Add(time.Duration(i)*time.Second))
+	}
+
+	select {
+	case <-changed:
+	default:
+		t.Fatal("expected Apply to close the Changed channel")
+	}
+
+	aircraft, ok := table.ByCallsign("ual2189", start.Add(10*time.Second))
+	if !ok {
+		t.Fatal("expected merged aircraft to be found by callsign")
+	}
+	if aircraft.Altitude != 35000 || aircraft.GroundSpeed != 450 || aircraft.Track != 274 || aircraft.VerticalRate != 1800 || !aircraft.HasPosition {
+		t.Fatalf("expected fields merged across messages, got %#v", aircraft)
+	}
+
+	later := start.Add(5 * time.Minute)
+	if _, ok := table.ByCallsign("UAL2189", later); ok {
+		t.Fatal("expected stale aircraft to be ignored")
+	}
+	if removed := table.Prune(later); removed != 1 || table.Len() != 0 {
+		t.Fatalf("expected stale aircraft to be pruned, removed %d, %d left", removed, table.Len())
+	}
+}
+
+func TestReadSkipsInvalidLines(t *testing.T) {
+	table := NewTable(time.Minute)
+	feed := strings.Join([]string{
+		"garbage",
+		"MSG,1,1,1,A1B2C3,1,,,,,BAW117,,,,,,,,,,,",
+		"STA,,5,179,400AE7,10103,2008/11/28,14:58:51.153,2008/11/28,14:58:51.153,RM",
+	}, "\n")
+
+	if err := Read(context.Background(), strings.NewReader(feed), table); err != nil {
+		t.Fatalf("Read returned error: %v", err)
+	}
+	if table.Len() != 1 {
+		t.Fatalf("expected one aircraft, got %d", table.Len())
+	}
+}
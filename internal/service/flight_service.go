@@ -3,19 +3,30 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/joshuachuah/flightcli/internal/airports"
 	"github.com/joshuachuah/flightcli/internal/cache"
 	"github.com/joshuachuah/flightcli/internal/models"
 	"github.com/joshuachuah/flightcli/internal/provider"
+	"github.com/joshuachuah/flightcli/internal/quota"
 )
 
 const (
 	flightStatusTTL = 60 * time.Second
 	airportTTL      = 5 * time.Minute
 	searchTTL       = 5 * time.Minute
+	scheduleTTL     = 6 * time.Hour
+	// historicalTTL applies to dates whose flights have all landed; their
+	// data will not change.
+	historicalTTL = 30 * 24 * time.Hour
+	// settledAfter is how long after the start of a flight date its
+	// results are considered final. A flight dated late in the day can
+	// still be in the air well into the next, in a later time zone.
+	settledAfter = 48 * time.Hour
 )
 
 // FlightService wraps a provider with optional caching.
@@ -23,6 +34,15 @@ const (
 type FlightService struct {
 	Provider provider.FlightProvider
 	Cache    *cache.Cache
+	// CacheScope namespaces cache keys so results from different providers
+	// never collide. Empty keeps the original unscoped keys.
+	CacheScope string
+	// Quota, when set, is told about lookups the cache answered so the
+	// usage ledger shows hits alongside API calls.
+	Quota *quota.Budget
+	// Options selects the date to look up and which part of large boards
+	// to fetch, for providers that support them.
+	Options provider.Options
 }
 
 // GetStatus fetches live flight status, using cache when available.
@@ -33,9 +53,12 @@ func (s *FlightService) GetStatus(ctx context.Context, flightNumber string) (*mo
 		return nil, false, fmt.Errorf("flight number is required")
 	}
 
-	return getOrFetch(ctx, s.Cache, fmt.Sprintf("status:%s", flightNumber), flightStatusTTL, func(ctx context.Context) (*models.Flight, error) {
-		return s.Provider.GetFlightStatus(ctx, flightNumber)
+	key := s.cacheKey(fmt.Sprintf("status:%s", flightNumber) + s.dateKey())
+	value, cached, err := getOrFetch(ctx, s.Cache, key, s.ttl(flightStatusTTL), func(ctx context.Context) (*models.Flight, error) {
+		return provider.StatusWithOptions(ctx, s.Provider, flightNumber, s.Options)
 	})
+	s.noteCacheHit(key, cached)
+	return value, cached, err
 }
 
 // GetAirportFlights fetches airport departure/arrival data, using cache when available.
@@ -51,9 +74,12 @@ func (s *FlightService) GetAirportFlights(ctx context.Context, airportCode, flig
 		return nil, false, fmt.Errorf("flight type is required")
 	}
 
-	return getOrFetch(ctx, s.Cache, fmt.Sprintf("airport:%s:%s", airportCode, flightType), airportTTL, func(ctx context.Context) ([]models.AirportFlight, error) {
-		return s.Provider.GetAirportFlights(ctx, airportCode, flightType)
+	key := s.cacheKey(fmt.Sprintf("airport:%s:%s", airportCode, flightType) + s.dateKey() + s.pageKey())
+	value, cached, err := getOrFetch(ctx, s.Cache, key, s.ttl(airportTTL), func(ctx context.Context) ([]models.AirportFlight, error) {
+		return provider.AirportFlightsWithOptions(ctx, s.Provider, airportCode, flightType, s.Options)
 	})
+	s.noteCacheHit(key, cached)
+	return value, cached, withAirportHint(err, airportCode)
 }
 
 // SearchFlights searches flights between two airports, using cache when available.
@@ -69,9 +95,108 @@ func (s *FlightService) SearchFlights(ctx context.Context, from, to string) ([]m
 		return nil, false, fmt.Errorf("arrival airport is required")
 	}
 
-	return getOrFetch(ctx, s.Cache, fmt.Sprintf("search:%s:%s", from, to), searchTTL, func(ctx context.Context) ([]models.AirportFlight, error) {
-		return s.Provider.SearchFlights(ctx, from, to)
+	key := s.cacheKey(fmt.Sprintf("search:%s:%s", from, to) + s.dateKey() + s.pageKey())
+	value, cached, err := getOrFetch(ctx, s.Cache, key, s.ttl(searchTTL), func(ctx context.Context) ([]models.AirportFlight, error) {
+		return provider.SearchWithOptions(ctx, s.Provider, from, to, s.Options)
 	})
+	s.noteCacheHit(key, cached)
+	return value, cached, withAirportHint(err, from, to)
+}
+
+// GetSchedule fetches the timetable between two airports on a future date,
+// searching the departures s.Options.Page selects, using cache when
+// available. Returns (flights, cached, error).
+func (s *FlightService) GetSchedule(ctx context.Context, from, to string, date time.Time) ([]models.ScheduledFlight, bool, error) {
+	from = strings.TrimSpace(from)
+	to = strings.TrimSpace(to)
+
+	if from == "" {
+		return nil, false, fmt.Errorf("departure airport is required")
+	}
+	if to == "" {
+		return nil, false, fmt.Errorf("arrival airport is required")
+	}
+	if date.IsZero() {
+		return nil, false, fmt.Errorf("date is required")
+	}
+
+	key := s.cacheKey(fmt.Sprintf("schedule:%s:%s:%s", from, to, date.Format(provider.DateLayout)) + s.pageKey())
+	value, cached, err := getOrFetch(ctx, s.Cache, key, scheduleTTL, func(ctx context.Context) ([]models.ScheduledFlight, error) {
+		return provider.Schedule(ctx, s.Provider, from, to, date, s.Options.Page)
+	})
+	s.noteCacheHit(key, cached)
+	return value, cached, withAirportHint(err, from, to)
+}
+
+// Updates returns a channel that is closed when a live provider receives
+// new data, or nil for request/response providers. A nil channel blocks
+// forever, so callers can select on it unconditionally.
+func (s *FlightService) Updates() <-chan struct{} {
+	if live, ok := s.Provider.(provider.LiveProvider); ok {
+		return live.Updates()
+	}
+	return nil
+}
+
+// withAirportHint adds the airport a mistyped code was probably meant to be
+// to a lookup that found nothing. Codes missing from the embedded table are
+// still looked up, since the table lists major airports only.
+func withAirportHint(err error, codes ...string) error {
+	if !errors.Is(err, provider.ErrNotFound) {
+		return err
+	}
+	for _, code := range codes {
+		if a := airports.Suggest(code); a != nil {
+			return fmt.Errorf("%w: did you mean %s (%s)?", err, a.IATA, a.Name)
+		}
+	}
+	return err
+}
+
+func (s *FlightService) noteCacheHit(key string, cached bool) {
+	if cached && s.Quota != nil {
+		s.Quota.RecordCacheHit(key)
+	}
+}
+
+// dateKey distinguishes cached lookups for different dates. Today's
+// lookups keep the original key.
+func (s *FlightService) dateKey() string {
+	if s.Options.Date.IsZero() {
+		return ""
+	}
+	return ":date=" + s.Options.Date.Format(provider.DateLayout)
+}
+
+// pageKey distinguishes cached boards fetched with different Page settings.
+// The default page keeps the original key.
+func (s *FlightService) pageKey() string {
+	page := s.Options.Page
+	if page == (provider.Page{}) {
+		return ""
+	}
+	return fmt.Sprintf(":page=%d,%d,%d,%s", page.Size, page.Number, page.Max, page.Window)
+}
+
+// ttl returns live for lookups that may still change and historicalTTL
+// for dates whose flights are long over.
+func (s *FlightService) ttl(live time.Duration) time.Duration {
+	date := s.Options.Date
+	if date.IsZero() {
+		return live
+	}
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local)
+	if time.Since(start) >= settledAfter {
+		return historicalTTL
+	}
+	return live
+}
+
+func (s *FlightService) cacheKey(key string) string {
+	if s.CacheScope == "" {
+		return key
+	}
+	return s.CacheScope + ":" + key
 }
 
 func getOrFetch[T any](
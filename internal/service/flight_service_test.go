@@ -2,12 +2,17 @@ package service
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/joshuachuah/flightcli/internal/cache"
 	"github.com/joshuachuah/flightcli/internal/models"
+	"github.com/joshuachuah/flightcli/internal/provider"
+	"github.com/joshuachuah/flightcli/internal/quota"
 )
 
 type stubProvider struct {
@@ -184,3 +189,164 @@ func TestGetStatusIgnoresCacheWriteFailures(t *testing.T) {
 		t.Fatalf("expected provider to be called for each request when cache writes fail, got %d", provider.statusCalls)
 	}
 }
+
+func TestCacheHitsAreRecordedInQuota(t *testing.T) {
+	ledger := &quota.Ledger{Path: filepath.Join(t.TempDir(), "usage.jsonl")}
+	service := FlightService{
+		Provider: &stubProvider{status: &models.Flight{FlightNumber: "AA100"}},
+		Cache:    &cache.Cache{Dir: t.TempDir()},
+		Quota:    &quota.Budget{Ledger: ledger},
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, _, err := service.GetStatus(context.Background(), "AA100"); err != nil {
+			t.Fatalf("GetStatus returned error: %v", err)
+		}
+	}
+	usage, err := ledger.Usage(time.Time{})
+	if err != nil {
+		t.Fatalf("Usage returned error: %v", err)
+	}
+	if usage.CacheHits != 2 {
+		t.Fatalf("expected 2 cache hits recorded, got %d", usage.CacheHits)
+	}
+}
+
+type optionsStubProvider struct {
+	stubProvider
+	opts []provider.Options
+}
+
+func (s *optionsStubProvider) GetFlightStatusWithOptions(ctx context.Context, flightNumber string, opts provider.Options) (*models.Flight, error) {
+	s.opts = append(s.opts, opts)
+	return &models.Flight{FlightNumber: flightNumber}, nil
+}
+
+func (s *optionsStubProvider) GetAirportFlightsWithOptions(ctx context.Context, airportCode string, flightType string, opts provider.Options) ([]models.AirportFlight, error) {
+	s.opts = append(s.opts, opts)
+	return []models.AirportFlight{{FlightNumber: "AA100"}}, nil
+}
+
+func (s *optionsStubProvider) SearchFlightsWithOptions(ctx context.Context, from, to string, opts provider.Options) ([]models.AirportFlight, error) {
+	s.opts = append(s.opts, opts)
+	return []models.AirportFlight{{FlightNumber: "AA100"}}, nil
+}
+
+func TestAirportFlightsPassPageAndCacheEachPageSeparately(t *testing.T) {
+	stub := &optionsStubProvider{}
+	service := FlightService{Provider: stub, Cache: &cache.Cache{Dir: t.TempDir()}}
+
+	pages := []provider.Page{{}, {Size: 20, Number: 2}, {Size: 20, Number: 2}}
+	for _, page := range pages {
+		service.Options.Page = page
+		if _, _, err := service.GetAirportFlights(context.Background(), "JFK", "departures"); err != nil {
+			t.Fatalf("GetAirportFlights returned error: %v", err)
+		}
+	}
+
+	if len(stub.opts) != 2 || stub.opts[1].Page != pages[1] {
+		t.Fatalf("expected two fetches, the second for page 2, got %#v", stub.opts)
+	}
+}
+
+func TestGetStatusCachesEachDateSeparately(t *testing.T) {
+	stub := &optionsStubProvider{}
+	dir := t.TempDir()
+	service := FlightService{Provider: stub, Cache: &cache.Cache{Dir: dir}}
+
+	past := time.Date(2026, 3, 10, 0, 0, 0, 0, time.Local)
+	for _, date := range []time.Time{{}, past, past} {
+		service.Options.Date = date
+		if _, _, err := service.GetStatus(context.Background(), "AA100"); err != nil {
+			t.Fatalf("GetStatus returned error: %v", err)
+		}
+	}
+	if len(stub.opts) != 2 || !stub.opts[1].Date.Equal(past) {
+		t.Fatalf("expected today and the past date fetched once each, got %#v", stub.opts)
+	}
+}
+
+func TestHistoricalLookupsUseLongTTL(t *testing.T) {
+	service := FlightService{}
+	if got := service.ttl(flightStatusTTL); got != flightStatusTTL {
+		t.Fatalf("expected today's TTL, got %s", got)
+	}
+
+	service.Options.Date = time.Now()
+	if got := service.ttl(flightStatusTTL); got != flightStatusTTL {
+		t.Fatalf("expected a date still in progress to keep the live TTL, got %s", got)
+	}
+
+	service.Options.Date = time.Now().AddDate(0, 0, -7)
+	if got := service.ttl(flightStatusTTL); got != historicalTTL {
+		t.Fatalf("expected last week's lookups cached for %s, got %s", historicalTTL, got)
+	}
+}
+
+func TestDatedLookupUnsupportedByPlainProvider(t *testing.T) {
+	service := FlightService{Provider: &stubProvider{}}
+	service.Options.Date = time.Date(2026, 3, 10, 0, 0, 0, 0, time.Local)
+
+	if _, _, err := service.GetStatus(context.Background(), "AA100"); !errors.Is(err, provider.ErrNotSupported) {
+		t.Fatalf("expected ErrNotSupported, got %v", err)
+	}
+}
+
+type notFoundStubProvider struct {
+	stubProvider
+}
+
+func (s *notFoundStubProvider) SearchFlights(ctx context.Context, from, to string) ([]models.AirportFlight, error) {
+	return nil, provider.ErrNotFound
+}
+
+func TestSearchNotFoundSuggestsMistypedAirport(t *testing.T) {
+	service := FlightService{Provider: &notFoundStubProvider{}}
+
+	_, _, err := service.SearchFlights(context.Background(), "JKF", "LAX")
+	if !errors.Is(err, provider.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if want := "no flights found: did you mean JFK (John F Kennedy International Airport)?"; err.Error() != want {
+		t.Fatalf("expected %q, got %q", want, err.Error())
+	}
+
+	// Codes that are not a swap of a known one get no hint.
+	_, _, err = service.SearchFlights(context.Background(), "YXU", "LAX")
+	if err == nil || strings.Contains(err.Error(), "did you mean") {
+		t.Fatalf("expected a plain not-found error, got %v", err)
+	}
+}
+
+type scheduleStubProvider struct {
+	stubProvider
+	scheduleCalls int
+}
+
+func (s *scheduleStubProvider) GetSchedule(ctx context.Context, from, to string, date time.Time, page provider.Page) ([]models.ScheduledFlight, error) {
+	s.scheduleCalls++
+	return []models.ScheduledFlight{{FlightNumber: "AA1", Origin: from, Destination: to, Date: date.Format(provider.DateLayout)}}, nil
+}
+
+func TestGetScheduleCachesEachRouteAndDate(t *testing.T) {
+	stub := &scheduleStubProvider{}
+	service := FlightService{Provider: stub, Cache: &cache.Cache{Dir: t.TempDir()}}
+
+	date := time.Date(2026, 12, 20, 0, 0, 0, 0, time.Local)
+	lookups := []time.Time{date, date, date.AddDate(0, 0, 1)}
+	for i, day := range lookups {
+		flights, cached, err := service.GetSchedule(context.Background(), "JFK", "LAX", day)
+		if err != nil {
+			t.Fatalf("GetSchedule returned error: %v", err)
+		}
+		if cached != (i == 1) {
+			t.Fatalf("lookup %d: expected cached=%v, got %v", i+1, i == 1, cached)
+		}
+		if len(flights) != 1 || flights[0].Date != day.Format(provider.DateLayout) {
+			t.Fatalf("unexpected schedule: %#v", flights)
+		}
+	}
+	if stub.scheduleCalls != 2 {
+		t.Fatalf("expected 2 provider calls, got %d", stub.scheduleCalls)
+	}
+}
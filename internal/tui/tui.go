@@ -8,7 +8,9 @@ import (
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/joshuachuah/flightcli/internal/display"
 	"github.com/joshuachuah/flightcli/internal/models"
+	"github.com/joshuachuah/flightcli/internal/provider"
 	"github.com/joshuachuah/flightcli/internal/service"
 )
 
@@ -19,6 +21,7 @@ const (
 	queryFlight
 	queryAirport
 	querySearch
+	querySchedule
 	queryHelp
 )
 
@@ -36,14 +39,18 @@ type query struct {
 	flightType string
 	from       string
 	to         string
+	// date is a YYYY-MM-DD day to look up instead of today, or empty.
+	date string
 }
 
 type resultPayload struct {
 	requestID int
 	query     query
 	cached    bool
+	quota     string
 	flight    *models.Flight
 	board     []models.AirportFlight
+	schedule  []models.ScheduledFlight
 	err       error
 }
 
@@ -62,7 +69,9 @@ type model struct {
 	activeRequest     int
 	requestCancel     context.CancelFunc
 	err               string
+	errHelp           []string
 	errID             int
+	quotaLabel        string
 	scrollback        []string
 	scrollOffset      int
 	lastUpdated       time.Time
@@ -70,6 +79,7 @@ type model struct {
 	lastCached        bool
 	flight            *models.Flight
 	flights           []models.AirportFlight
+	schedule          []models.ScheduledFlight
 	activeTitle       string
 	statusMessage     string
 	spinnerFrame      int
@@ -99,6 +109,7 @@ func initialModel(ctx context.Context, svc service.FlightService) model {
 		statusMessage:   "Type /help for commands",
 		historyIndex:    -1,
 		completionIndex: -1,
+		quotaLabel:      quotaLabel(svc),
 	}
 }
 
@@ -129,6 +140,9 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, spinnerTick()
 	case resultPayload:
+		if msg.quota != "" {
+			m.quotaLabel = msg.quota
+		}
 		if msg.requestID != m.activeRequest {
 			return m, nil
 		}
@@ -136,6 +150,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.requestCancel = nil
 		if msg.err != nil {
 			cmd := m.setError(msg.err.Error())
+			m.errHelp = display.ErrorHelp(msg.err)
 			m.statusMessage = "Request failed"
 			// Append error to scrollback
 			m.scrollback = append(m.scrollback, m.renderErrorBlock())
@@ -150,6 +165,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.lastCached = msg.cached
 		m.flight = msg.flight
 		m.flights = msg.board
+		m.schedule = msg.schedule
 		m.lastUpdated = time.Now()
 		m.activeTitle = titleForQuery(msg.query)
 		m.statusMessage = "Type /help for commands"
@@ -324,6 +340,7 @@ func (m *model) startRequest(q query) tea.Cmd {
 
 func (m *model) setError(message string) tea.Cmd {
 	m.err = message
+	m.errHelp = nil
 	m.errID++
 	id := m.errID
 	return tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
@@ -434,6 +451,7 @@ func matchingSlashCommands(prefix string) []string {
 		"/track",
 		"/airport",
 		"/search",
+		"/schedule",
 		"/help",
 		"/quit",
 		"/flight",
@@ -456,18 +474,51 @@ func fetchQueryCmd(ctx context.Context, cancel context.CancelFunc, svc service.F
 	return func() tea.Msg {
 		defer cancel()
 
-		switch q.kind {
-		case queryFlight:
-			flight, cached, err := svc.GetStatus(ctx, q.flight)
-			return resultPayload{requestID: requestID, query: q, flight: flight, cached: cached, err: err}
-		case queryAirport:
-			flights, cached, err := svc.GetAirportFlights(ctx, q.airport, q.flightType)
-			return resultPayload{requestID: requestID, query: q, board: flights, cached: cached, err: err}
-		case querySearch:
-			flights, cached, err := svc.SearchFlights(ctx, q.from, q.to)
-			return resultPayload{requestID: requestID, query: q, board: flights, cached: cached, err: err}
-		default:
-			return resultPayload{requestID: requestID, query: q, err: fmt.Errorf("unsupported query")}
+		result := runQuery(ctx, svc, requestID, q)
+		result.quota = quotaLabel(svc)
+		return result
+	}
+}
+
+func runQuery(ctx context.Context, svc service.FlightService, requestID int, q query) resultPayload {
+	if q.date != "" {
+		date, err := time.ParseInLocation(provider.DateLayout, q.date, time.Local)
+		if err != nil {
+			return resultPayload{requestID: requestID, query: q, err: err}
 		}
+		svc.Options.Date = date
+	}
+
+	switch q.kind {
+	case queryFlight:
+		flight, cached, err := svc.GetStatus(ctx, q.flight)
+		return resultPayload{requestID: requestID, query: q, flight: flight, cached: cached, err: err}
+	case queryAirport:
+		flights, cached, err := svc.GetAirportFlights(ctx, q.airport, q.flightType)
+		return resultPayload{requestID: requestID, query: q, board: models.CollapseCodeshares(flights), cached: cached, err: err}
+	case querySearch:
+		flights, cached, err := svc.SearchFlights(ctx, q.from, q.to)
+		return resultPayload{requestID: requestID, query: q, board: flights, cached: cached, err: err}
+	case querySchedule:
+		flights, cached, err := svc.GetSchedule(ctx, q.from, q.to, svc.Options.Date)
+		return resultPayload{requestID: requestID, query: q, schedule: flights, cached: cached, err: err}
+	default:
+		return resultPayload{requestID: requestID, query: q, err: fmt.Errorf("unsupported query")}
+	}
+}
+
+// quotaLabel describes the API calls left this month for the status bar,
+// or returns "" when no budget is being tracked.
+func quotaLabel(svc service.FlightService) string {
+	if svc.Quota == nil {
+		return ""
+	}
+	remaining, limited, err := svc.Quota.Remaining()
+	if err != nil || !limited {
+		return ""
+	}
+	if remaining == 1 {
+		return "1 call left"
 	}
+	return fmt.Sprintf("%d calls left", remaining)
 }
@@ -2,6 +2,7 @@ package tui
 
 import (
 	"context"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -9,6 +10,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/joshuachuah/flightcli/internal/models"
 	"github.com/joshuachuah/flightcli/internal/provider"
+	"github.com/joshuachuah/flightcli/internal/quota"
 	"github.com/joshuachuah/flightcli/internal/service"
 )
 
@@ -16,12 +18,15 @@ func TestTitleForQuery(t *testing.T) {
 	if got := titleForQuery(query{kind: queryFlight, flight: "aa100"}); got != "Flight AA100" {
 		t.Fatalf("unexpected flight title: %q", got)
 	}
-	if got := titleForQuery(query{kind: queryAirport, airport: "jfk", flightType: "arrivals"}); got != "Arrivals for JFK" {
+	if got := titleForQuery(query{kind: queryAirport, airport: "jfk", flightType: "arrivals"}); got != "Arrivals for JFK (John F Kennedy International Airport)" {
 		t.Fatalf("unexpected airport title: %q", got)
 	}
 	if got := titleForQuery(query{kind: querySearch, from: "jfk", to: "lax"}); got != "JFK → LAX" {
 		t.Fatalf("unexpected search title: %q", got)
 	}
+	if got := titleForQuery(query{kind: queryFlight, flight: "aa100", date: "2026-03-10"}); got != "Flight AA100 · Tue, Mar 10 2026" {
+		t.Fatalf("unexpected dated flight title: %q", got)
+	}
 }
 
 func TestFormatFlightIncludesRouteAndTelemetry(t *testing.T) {
@@ -68,6 +73,38 @@ func TestFormatBoardIncludesRows(t *testing.T) {
 	}
 }
 
+func TestFormatBoardShowsGateForBoardSide(t *testing.T) {
+	flights := []models.AirportFlight{{
+		FlightNumber:     "DL200",
+		Origin:           "JFK",
+		Destination:      "LAX",
+		Status:           "Scheduled",
+		DepartureDetails: models.LegDetails{Terminal: "4", Gate: "B22"},
+		ArrivalDetails:   models.LegDetails{Gate: "52A", Baggage: "7"},
+	}}
+
+	if output := formatBoardForWidth(flights, false, 80); !strings.Contains(output, "GATE") || !strings.Contains(output, "T4 B22") {
+		t.Fatalf("expected departure gate column, got %q", output)
+	}
+	if output := formatBoardForWidth(flights, true, 80); !strings.Contains(output, "52A belt 7") {
+		t.Fatalf("expected arrival gate and belt, got %q", output)
+	}
+	if output := formatBoardForWidth(flights, false, 60); strings.Contains(output, "B22") {
+		t.Fatalf("expected narrow board to drop the gate column, got %q", output)
+	}
+}
+
+func TestFormatBoardListsCodesharesWhenWide(t *testing.T) {
+	flights := []models.AirportFlight{{FlightNumber: "AA100", Origin: "JFK", Destination: "LHR", Codeshares: []string{"BA1511"}}}
+
+	if output := formatBoardForWidth(flights, false, 100); !strings.Contains(output, "CODESHARES") || !strings.Contains(output, "also BA1511") {
+		t.Fatalf("expected codeshare column on a wide board, got %q", output)
+	}
+	if output := formatBoardForWidth(flights, false, 80); strings.Contains(output, "BA1511") {
+		t.Fatalf("expected 80-column board to drop codeshares, got %q", output)
+	}
+}
+
 func TestFormatBoardSanitizesTerminalControls(t *testing.T) {
 	output := formatBoard([]models.AirportFlight{
 		{
@@ -236,6 +273,31 @@ func TestParseSlashCommand(t *testing.T) {
 			input: "/search JFK LAX",
 			want:  query{kind: querySearch, from: "JFK", to: "LAX"},
 		},
+		{
+			name:  "track on a date",
+			input: "/track AA100 2026-03-10",
+			want:  query{kind: queryFlight, flight: "AA100", date: "2026-03-10"},
+		},
+		{
+			name:  "track a radio callsign",
+			input: "/track SPEEDBIRD 12 2026-03-10",
+			want:  query{kind: queryFlight, flight: "SPEEDBIRD 12", date: "2026-03-10"},
+		},
+		{
+			name:  "airport on a date",
+			input: "/airport JFK 2026-03-10",
+			want:  query{kind: queryAirport, airport: "JFK", flightType: "departures", date: "2026-03-10"},
+		},
+		{
+			name:  "search on a date",
+			input: "/search JFK LAX 2026-03-10",
+			want:  query{kind: querySearch, from: "JFK", to: "LAX", date: "2026-03-10"},
+		},
+		{
+			name:  "schedule",
+			input: "/schedule JFK LAX 2099-12-20",
+			want:  query{kind: querySchedule, from: "JFK", to: "LAX", date: "2099-12-20"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -254,6 +316,33 @@ func TestParseSlashCommand(t *testing.T) {
 	}
 }
 
+func TestParseScheduleRequiresDate(t *testing.T) {
+	if _, _, err := parseSlashCommand("/schedule JFK LAX"); err == nil || !strings.Contains(err.Error(), "YYYY-MM-DD") {
+		t.Fatalf("expected usage error naming the date, got %v", err)
+	}
+	if _, _, err := parseSlashCommand("/schedule JFK LAX 2020-01-01"); err == nil || !strings.Contains(err.Error(), "in the past") {
+		t.Fatalf("expected a past date to be rejected, got %v", err)
+	}
+	if got := matchingSlashCommands("/sch"); len(got) != 1 || got[0] != "/schedule" {
+		t.Fatalf("expected /sch to complete to /schedule, got %q", got)
+	}
+}
+
+func TestParseAcceptsAirportsMissingFromTable(t *testing.T) {
+	for _, input := range []string{"/airport ALS", "/search LSC OBS", "/schedule ASN ROD 2099-12-20"} {
+		if _, _, err := parseSlashCommand(input); err != nil {
+			t.Errorf("%s: expected the code to be looked up, got %v", input, err)
+		}
+	}
+}
+
+func TestParseRejectsUnknownRadioCallsign(t *testing.T) {
+	_, _, err := parseSlashCommand("/track SPEEDBRID 12")
+	if err == nil || !strings.Contains(err.Error(), "did you mean SPEEDBIRD") {
+		t.Fatalf("expected a SPEEDBIRD suggestion, got %v", err)
+	}
+}
+
 func TestHomeSlashCommandStartsRequest(t *testing.T) {
 	m := initialModel(context.Background(), serviceStub())
 	m.commandInput = "/search JFK LAX"
@@ -517,3 +606,37 @@ func TestSearchHistoryNavigation(t *testing.T) {
 		t.Fatalf("expected down arrow at end of history to clear input, got %q", next4.commandInput)
 	}
 }
+
+func TestResultErrorShowsRemediation(t *testing.T) {
+	m := initialModel(context.Background(), serviceStub())
+	m.width = 100
+	m.height = 30
+
+	updated, _ := m.Update(resultPayload{
+		requestID: m.activeRequest,
+		query:     query{kind: queryFlight, flight: "UA2189"},
+		err:       &provider.APIError{StatusCode: 401, Code: "invalid_access_key", Message: "Invalid key."},
+	})
+	next := updated.(model)
+
+	output := next.View()
+	if !strings.Contains(output, "Invalid key.") || !strings.Contains(output, "AVIATIONSTACK_API_KEY") {
+		t.Fatalf("expected error and remediation in scrollback, got:\n%s", output)
+	}
+}
+
+func TestStatusBarShowsRemainingCalls(t *testing.T) {
+	ledger := &quota.Ledger{Path: filepath.Join(t.TempDir(), "usage.jsonl")}
+	budget := &quota.Budget{Ledger: ledger, Limit: 100}
+	if err := budget.Reserve("/v1/flights", nil); err != nil {
+		t.Fatalf("Reserve returned error: %v", err)
+	}
+
+	m := initialModel(context.Background(), service.FlightService{Quota: budget})
+	m.width = 100
+	m.height = 30
+
+	if output := m.View(); !strings.Contains(output, "99 calls left") {
+		t.Fatalf("expected remaining calls in status bar, got:\n%s", output)
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"unicode/utf8"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/joshuachuah/flightcli/internal/airports"
 	"github.com/joshuachuah/flightcli/internal/display"
 	"github.com/joshuachuah/flightcli/internal/models"
+	"github.com/joshuachuah/flightcli/internal/provider"
 	"github.com/joshuachuah/flightcli/internal/sanitize"
 )
 
@@ -110,10 +112,14 @@ func (m model) renderStatusBar() string {
 		title = " " + sanitize.TerminalString(m.activeTitle)
 	}
 
-	rightPart := ""
+	var right []string
+	if m.quotaLabel != "" {
+		right = append(right, m.quotaLabel)
+	}
 	if !m.lastUpdated.IsZero() {
-		rightPart = m.lastUpdated.Format("15:04:05")
+		right = append(right, m.lastUpdated.Format("15:04:05"))
 	}
+	rightPart := strings.Join(right, " · ")
 
 	// Compose a single line, then render once so padding is applied only at edges
 	leftLen := lipgloss.Width(title)
@@ -217,9 +223,10 @@ func (m model) viewHelp() string {
 		cmd  string
 		desc string
 	}{
-		{"/track [flight]", "Track a flight by number"},
-		{"/airport [code]", "Show airport board (departures/arrivals)"},
-		{"/search [from] [to]", "Search routes between airports"},
+		{"/track [flight] [date]", "Track a flight by number or radio callsign, optionally on a past YYYY-MM-DD"},
+		{"/airport [code] [date]", "Show airport board (departures/arrivals)"},
+		{"/search [from] [to] [date]", "Search routes between airports"},
+		{"/schedule [from] [to] [date]", "Show the timetable for a future date"},
 		{"/help", "Show this help screen"},
 		{"/quit", "Exit FlightCLI"},
 	}
@@ -270,9 +277,9 @@ func (m model) viewHelp() string {
 func (m model) viewResult() string {
 	var b strings.Builder
 
-	// Cached badge
-	if m.lastCached {
-		b.WriteString(cachedStyle.Render("  ◷ cached"))
+	// Cached / source badge
+	if label := display.SourceLabel(m.lastCached, m.resultSource()); label != "" {
+		b.WriteString(cachedStyle.Render("  ◷ " + label))
 		b.WriteString("\n\n")
 	}
 
@@ -289,8 +296,10 @@ func (m model) viewResult() string {
 		content = formatFlight(m.flight)
 	} else if m.lastQuery.kind == querySearch {
 		content = formatSearchResults(m.flights)
+	} else if m.lastQuery.kind == querySchedule {
+		content = formatScheduleForWidth(m.schedule, m.width)
 	} else {
-		content = formatBoardForWidth(m.flights, m.width)
+		content = formatBoardForWidth(m.flights, m.lastQuery.flightType == "arrivals", m.width)
 	}
 
 	b.WriteString(panelStyle.Render(content))
@@ -304,9 +313,27 @@ func (m model) renderResultBlock() string {
 	return title + "\n" + body
 }
 
+// resultSource returns the provider that answered the current result.
+func (m model) resultSource() string {
+	if m.flight != nil {
+		return m.flight.Source
+	}
+	if len(m.flights) > 0 {
+		return m.flights[0].Source
+	}
+	if len(m.schedule) > 0 {
+		return m.schedule[0].Source
+	}
+	return ""
+}
+
 // renderErrorBlock renders the current error for storage in the scrollback buffer.
 func (m model) renderErrorBlock() string {
-	return errorStyle.Render("  ✗ " + sanitize.TerminalString(m.err))
+	block := errorStyle.Render("  ✗ " + sanitize.TerminalString(m.err))
+	for _, line := range m.errHelp {
+		block += "\n" + hintStyle.Render("    "+line)
+	}
+	return block
 }
 
 func formatFlight(flight *models.Flight) string {
@@ -318,10 +345,10 @@ func formatFlightAt(flight *models.Flight, now time.Time) string {
 }
 
 func formatBoard(flights []models.AirportFlight) string {
-	return formatBoardForWidth(flights, 80)
+	return formatBoardForWidth(flights, false, 80)
 }
 
-func formatBoardForWidth(flights []models.AirportFlight, width int) string {
+func formatBoardForWidth(flights []models.AirportFlight, arrivals bool, width int) string {
 	if len(flights) == 0 {
 		return "No flights found."
 	}
@@ -333,9 +360,12 @@ func formatBoardForWidth(flights []models.AirportFlight, width int) string {
 	// Header row
 	var header string
 	switch {
+	case width >= 100:
+		header = tableHeaderStyle.Render(fmt.Sprintf("%-8s %-22s %-11s %-10s %-11s %-14s %s",
+			"FLIGHT", "AIRLINE", "ROUTE", "STATUS", "TIME", "GATE", "CODESHARES"))
 	case width >= 80:
-		header = tableHeaderStyle.Render(fmt.Sprintf("%-8s %-22s %-11s %-10s %s",
-			"FLIGHT", "AIRLINE", "ROUTE", "STATUS", "TIME"))
+		header = tableHeaderStyle.Render(fmt.Sprintf("%-8s %-22s %-11s %-10s %-11s %s",
+			"FLIGHT", "AIRLINE", "ROUTE", "STATUS", "TIME", "GATE"))
 	case width >= 60:
 		header = tableHeaderStyle.Render(fmt.Sprintf("%-8s %-11s %-10s %s",
 			"FLIGHT", "ROUTE", "STATUS", "TIME"))
@@ -356,17 +386,31 @@ func formatBoardForWidth(flights []models.AirportFlight, width int) string {
 		if !f.ScheduledTime.IsZero() {
 			scheduled = f.ScheduledTime.Format("15:04")
 		}
+		if delay := display.BoardDelay(f, arrivals); delay != "" {
+			scheduled = strings.TrimSpace(scheduled + " " + delay)
+		}
 		statusStyled := statusStyleForFlight(status).Render(trimForWidth(status, 10))
 		route := origin + "->" + destination
 		switch {
+		case width >= 100:
+			lines = append(lines, strings.TrimRight(fmt.Sprintf("%-8s %-22s %-11s %-10s %-11s %-14s %s",
+				flightNumber,
+				trimForWidth(airline, 22),
+				route,
+				statusStyled,
+				scheduled,
+				display.BoardGate(f, arrivals),
+				display.BoardCodeshares(f),
+			), " "))
 		case width >= 80:
-			lines = append(lines, fmt.Sprintf("%-8s %-22s %-11s %-10s %s",
+			lines = append(lines, strings.TrimRight(fmt.Sprintf("%-8s %-22s %-11s %-10s %-11s %s",
 				flightNumber,
 				trimForWidth(airline, 22),
 				route,
 				statusStyled,
 				scheduled,
-			))
+				display.BoardGate(f, arrivals),
+			), " "))
 		case width >= 60:
 			lines = append(lines, fmt.Sprintf("%-8s %-11s %-10s %s",
 				flightNumber,
@@ -385,6 +429,34 @@ func formatBoardForWidth(flights []models.AirportFlight, width int) string {
 	return strings.Join(lines, "\n")
 }
 
+func formatScheduleForWidth(flights []models.ScheduledFlight, width int) string {
+	if len(flights) == 0 {
+		return "No flights found."
+	}
+
+	wide := width >= 80
+	var lines []string
+	if wide {
+		lines = append(lines, tableHeaderStyle.Render(fmt.Sprintf("%-8s %-22s %-16s %s", "FLIGHT", "AIRLINE", "TIMES", "OPERATOR")))
+	} else {
+		lines = append(lines, tableHeaderStyle.Render(fmt.Sprintf("%-8s %-16s %s", "FLIGHT", "TIMES", "OPERATOR")))
+	}
+	for _, f := range flights {
+		flightNumber := sanitize.TerminalString(f.FlightNumber)
+		operator := ""
+		if f.Codeshare() {
+			operator = sanitize.TerminalString(f.OperatingFlightNumber)
+		}
+		if wide {
+			airline := trimForWidth(sanitize.TerminalString(f.Airline), 22)
+			lines = append(lines, strings.TrimRight(fmt.Sprintf("%-8s %-22s %-16s %s", flightNumber, airline, display.ScheduleTimes(f), operator), " "))
+		} else {
+			lines = append(lines, strings.TrimRight(fmt.Sprintf("%-8s %-16s %s", flightNumber, display.ScheduleTimes(f), operator), " "))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
 func formatSearchResults(flights []models.AirportFlight) string {
 	if len(flights) == 0 {
 		return "No flights found."
@@ -412,15 +484,28 @@ func parseSlashCommand(input string) (query, bool, error) {
 	command := strings.ToLower(strings.TrimPrefix(fields[0], "/"))
 	args := fields[1:]
 
+	// Lookups take an optional trailing YYYY-MM-DD date.
+	var date string
+	if n := len(args); n > 1 {
+		if _, err := time.Parse(provider.DateLayout, args[n-1]); err == nil {
+			date, args = args[n-1], args[:n-1]
+		}
+	}
+
 	switch command {
 	case "track", "flight", "status":
-		if len(args) != 1 {
-			return query{}, false, fmt.Errorf("usage: /track AA100")
+		if len(args) < 1 {
+			return query{}, false, fmt.Errorf("usage: /track AA100 [YYYY-MM-DD]")
 		}
-		return query{kind: queryFlight, flight: args[0]}, false, nil
+		// Radio callsigns are spoken as two words: /track SPEEDBIRD 12.
+		flight := strings.Join(args, " ")
+		if err := provider.CheckFlightNumber(flight); err != nil {
+			return query{}, false, err
+		}
+		return query{kind: queryFlight, flight: flight, date: date}, false, nil
 	case "airport", "board":
 		if len(args) < 1 || len(args) > 2 {
-			return query{}, false, fmt.Errorf("usage: /airport JFK departures")
+			return query{}, false, fmt.Errorf("usage: /airport JFK departures [YYYY-MM-DD]")
 		}
 		flightType := "departures"
 		if len(args) == 2 {
@@ -429,21 +514,34 @@ func parseSlashCommand(input string) (query, bool, error) {
 		if flightType != "departures" && flightType != "arrivals" {
 			return query{}, false, fmt.Errorf("board type must be departures or arrivals")
 		}
-		q := query{kind: queryAirport, airport: args[0], flightType: flightType}
-		if !airportCodePattern.MatchString(strings.ToUpper(q.airport)) {
-			return query{}, false, fmt.Errorf("invalid airport code %q: use a 3-letter IATA code", q.airport)
+		q := query{kind: queryAirport, airport: args[0], flightType: flightType, date: date}
+		if err := checkAirportCode(q.airport); err != nil {
+			return query{}, false, err
 		}
 		return q, false, nil
 	case "search", "route":
 		if len(args) != 2 {
-			return query{}, false, fmt.Errorf("usage: /search JFK LAX")
+			return query{}, false, fmt.Errorf("usage: /search JFK LAX [YYYY-MM-DD]")
+		}
+		q := query{kind: querySearch, from: args[0], to: args[1], date: date}
+		for _, code := range []string{q.from, q.to} {
+			if err := checkAirportCode(code); err != nil {
+				return query{}, false, err
+			}
 		}
-		q := query{kind: querySearch, from: args[0], to: args[1]}
-		if !airportCodePattern.MatchString(strings.ToUpper(q.from)) {
-			return query{}, false, fmt.Errorf("invalid airport code %q: use a 3-letter IATA code", q.from)
+		return q, false, nil
+	case "schedule":
+		if len(args) != 2 || date == "" {
+			return query{}, false, fmt.Errorf("usage: /schedule JFK LAX YYYY-MM-DD")
 		}
-		if !airportCodePattern.MatchString(strings.ToUpper(q.to)) {
-			return query{}, false, fmt.Errorf("invalid airport code %q: use a 3-letter IATA code", q.to)
+		q := query{kind: querySchedule, from: args[0], to: args[1], date: date}
+		if day, err := time.ParseInLocation(provider.DateLayout, date, time.Local); err == nil && provider.BeforeToday(day, time.Now()) {
+			return query{}, false, fmt.Errorf("%s is in the past: use /search JFK LAX %s for past flights", date, date)
+		}
+		for _, code := range []string{q.from, q.to} {
+			if err := checkAirportCode(code); err != nil {
+				return query{}, false, err
+			}
 		}
 		return q, false, nil
 	case "help":
@@ -455,6 +553,21 @@ func parseSlashCommand(input string) (query, bool, error) {
 	}
 }
 
+// checkAirportCode rejects codes that are not three letters, or that the
+// airports table rules out.
+func checkAirportCode(code string) error {
+	if !airportCodePattern.MatchString(strings.ToUpper(code)) {
+		return fmt.Errorf("invalid airport code %q: use a 3-letter IATA code", code)
+	}
+	if airports.Unknown(code) {
+		if a := airports.Suggest(code); a != nil {
+			return fmt.Errorf("unknown airport code %q: did you mean %s (%s)?", code, a.IATA, a.Name)
+		}
+		return fmt.Errorf("unknown airport code %q", code)
+	}
+	return nil
+}
+
 func loadingMessage(q query) string {
 	switch q.kind {
 	case queryFlight:
@@ -463,23 +576,32 @@ func loadingMessage(q query) string {
 		return "Fetching airport board..."
 	case querySearch:
 		return "Searching route..."
+	case querySchedule:
+		return "Fetching schedule..."
 	default:
 		return "Loading..."
 	}
 }
 
 func titleForQuery(q query) string {
+	var title string
 	switch q.kind {
 	case queryFlight:
-		return "Flight " + strings.ToUpper(q.flight)
+		title = "Flight " + strings.ToUpper(q.flight)
 	case queryAirport:
 		label := capitalize(strings.ToLower(q.flightType))
-		return label + " for " + strings.ToUpper(q.airport)
+		title = label + " for " + display.AirportLabel(q.airport)
 	case querySearch:
-		return strings.ToUpper(q.from) + " → " + strings.ToUpper(q.to)
+		title = strings.ToUpper(q.from) + " → " + strings.ToUpper(q.to)
+	case querySchedule:
+		title = "Schedule " + strings.ToUpper(q.from) + " → " + strings.ToUpper(q.to)
 	default:
 		return "FlightCLI"
 	}
+	if q.date != "" {
+		title += " · " + display.FlightDateText(q.date)
+	}
+	return title
 }
 
 func trimForWidth(s string, width int) string {